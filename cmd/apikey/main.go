@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"godemo/internal/app"
+	"godemo/internal/dto"
+	"godemo/internal/wire"
+
+	_ "godemo/config"
+)
+
+// 主函数
+// API Key 管理命令，用于创建、停用及查看合作方的 API Key。
+// secret 只在创建时输出一次，数据库中不保存 secret，遗失后停用并重新创建。
+// secret 由 [business.api_key] 中的 pepper 派生，修改 pepper 后所有 API Key 都会失效。
+//
+//	go run ./cmd/apikey -c config/debug.toml -create partner-a -scopes users:read,users:write -expires 8760h
+//	go run ./cmd/apikey -c config/debug.toml -revoke ak_xxx
+//	go run ./cmd/apikey -c config/debug.toml -list
+func main() {
+	var configFile, name, scopes, revokeKeyID string
+	var expires time.Duration
+	var list bool
+
+	flag.StringVar(&configFile, "c", "config.yml", "config file path")
+	flag.StringVar(&name, "create", "", "create an api key with the given name")
+	flag.StringVar(&scopes, "scopes", "", "comma separated scopes of the new api key, * for all")
+	flag.DurationVar(&expires, "expires", 0, "lifetime of the new api key, 0 means never expires")
+	flag.StringVar(&revokeKeyID, "revoke", "", "revoke the api key with the given key id")
+	flag.BoolVar(&list, "list", false, "list all api keys")
+	flag.Parse()
+
+	if _, err := app.NewApp("godemo-apikey", configFile); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create app: %v\n", err)
+		os.Exit(1)
+	}
+
+	svc, err := wire.InitializeAPIKeyService()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize dependencies: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	switch {
+	case name != "":
+		if scopes == "" {
+			fmt.Fprintln(os.Stderr, "-scopes is required")
+			os.Exit(2)
+		}
+		resp, err := svc.Create(ctx, &dto.APIKeyCreateRequest{
+			Name:      name,
+			Scopes:    strings.Split(scopes, ","),
+			ExpiresIn: expires,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create api key: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stdout, "key_id:     %s\nsecret:     %s\nscopes:     %s\nexpires_at: %s\n", resp.KeyID, resp.Secret, resp.Scopes, resp.ExpiresAt)
+		fmt.Fprintln(os.Stdout, "The secret is shown only once, store it securely.")
+	case revokeKeyID != "":
+		if err := svc.Revoke(ctx, revokeKeyID); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to revoke api key %s: %v\n", revokeKeyID, err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stdout, "API key %s revoked.\n", revokeKeyID)
+	case list:
+		keys, err := svc.List(ctx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to list api keys: %v\n", err)
+			os.Exit(1)
+		}
+		for _, key := range keys {
+			fmt.Fprintf(os.Stdout, "%s\t%s\t%s\texpires_at=%s\tlast_used_at=%s\tactive=%t\n",
+				key.KeyID, key.Name, key.Scopes, key.ExpiresAt, key.LastUsedAt, key.Active(time.Now()))
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
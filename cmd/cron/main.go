@@ -20,6 +20,11 @@ type cronServer struct {
 	cronApp *cron.App
 }
 
+// Name identifies the server in logs and errors.
+func (s *cronServer) Name() string {
+	return "cron"
+}
+
 // Start begins the cron scheduler.
 func (s *cronServer) Start(ctx context.Context) error {
 	return s.cronApp.Start(ctx)
@@ -74,6 +79,6 @@ func main() {
 
 	if err := application.Run(ctx); err != nil {
 		fmt.Fprintf(os.Stderr, "Application run failed: %v\n", err)
-		os.Exit(1)
+		os.Exit(app.ExitCode(err))
 	}
 }
@@ -8,7 +8,9 @@ import (
 	"os"
 	"time"
 
+	"godemo/config"
 	"godemo/internal/app"
+	"godemo/internal/area"
 	"godemo/internal/dto"
 	"godemo/internal/router"
 	"godemo/internal/wire"
@@ -16,8 +18,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
-
-	_ "godemo/config"
+	"github.com/jessewkun/gocommon/oss"
 
 	_ "github.com/jessewkun/gocommon/debug"
 	_ "github.com/jessewkun/gocommon/http"
@@ -29,6 +30,11 @@ type apiServer struct {
 	apis *wire.APIs
 }
 
+// Name identifies the server in logs and errors.
+func (s *apiServer) Name() string {
+	return "http " + s.srv.Addr
+}
+
 // Start begins listening for HTTP requests.
 func (s *apiServer) Start(ctx context.Context) error {
 	if err := s.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -49,17 +55,23 @@ func (s *apiServer) Stop(ctx context.Context) error {
 func newAPIServer(opts *app.Options, apis *wire.APIs) (*apiServer, error) {
 	gin.SetMode(opts.BaseConfig.Mode)
 	r := gin.New()
+	// gin 默认信任所有代理，客户端可以通过 X-Forwarded-For 伪造 IP，这里只信任配置的代理
+	if err := r.SetTrustedProxies(config.BusinessCfg.SecurityConfig().TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		dto.RegisterValidator(v)
 	}
+	binding.Validator = dto.NormalizeValidator(binding.Validator)
 
+	timeouts := config.BusinessCfg.TimeoutConfig()
 	srv := &http.Server{
 		Addr:         opts.BaseConfig.Port,
 		Handler:      router.InitRouter(r, apis),
-		ReadTimeout:  5 * 60 * time.Second,
-		WriteTimeout: 5 * 60 * time.Second,
-		IdleTimeout:  300 * time.Second,
+		ReadTimeout:  durationOr(timeouts.Read, 5*time.Minute),
+		WriteTimeout: durationOr(timeouts.Write, 5*time.Minute),
+		IdleTimeout:  durationOr(timeouts.Idle, 5*time.Minute),
 	}
 
 	return &apiServer{
@@ -68,6 +80,32 @@ func newAPIServer(opts *app.Options, apis *wire.APIs) (*apiServer, error) {
 	}, nil
 }
 
+// durationOr 返回 d，未配置时返回 def
+func durationOr(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
+// newAreaReloader 根据配置创建省市区数据加载器，未配置外部数据源时返回 nil
+func newAreaReloader(cfg *config.BusinessConfig) (*area.Reloader, error) {
+	var source area.Source
+	switch {
+	case cfg.Area.DatasetFile != "":
+		source = area.FileSource(cfg.Area.DatasetFile)
+	case cfg.Area.DatasetOssKey != "":
+		client, err := oss.NewOssSimple(cfg.Oss.Endpoint, cfg.Oss.AccessKey, cfg.Oss.SecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create oss client: %w", err)
+		}
+		source = area.OssSource{Client: client, Bucket: cfg.Oss.Bucket, Key: cfg.Area.DatasetOssKey}
+	default:
+		return nil, nil
+	}
+	return area.NewReloader(area.Default(), source, cfg.Area.RefreshInterval), nil
+}
+
 // 主函数
 // 这个函数是整个应用的入口，它负责创建应用程序实例，初始化API服务器，并启动应用程序。
 // 这里的错误直接输出，没有进入日志，是因为可以在 github action 中看到错误或者手动运行时看到错误，方便排查问题。
@@ -95,8 +133,21 @@ func main() {
 		os.Exit(1)
 	}
 	application.AddServer(apiSrv)
+
+	areaReloader, err := newAreaReloader(config.BusinessCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create area reloader: %v\n", err)
+		os.Exit(1)
+	}
+	if areaReloader != nil {
+		// 省市区数据替换后清除接口的响应缓存，新数据立即生效
+		areaReloader.OnSwap(func(ctx context.Context) error {
+			return apis.ResponseCache.Purge(ctx, router.AreaCacheTag)
+		})
+		application.AddServer(areaReloader)
+	}
 	if err := application.Run(ctx); err != nil {
 		fmt.Fprintf(os.Stderr, "Application run failed: %v\n", err)
-		os.Exit(1)
+		os.Exit(app.ExitCode(err))
 	}
 }
@@ -2,29 +2,157 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
 
 	xconfig "github.com/jessewkun/gocommon/config"
 	xcron "github.com/jessewkun/gocommon/cron"
+	"github.com/jessewkun/gocommon/logger"
 	"github.com/jessewkun/gocommon/middleware"
 	"github.com/spf13/viper"
+	"golang.org/x/mod/semver"
 )
 
 // BusinessConfig 业务配置
 type BusinessConfig struct {
-	Cros  middleware.CrosConfig `mapstructure:"cros" json:"cros"` // 跨域配置
-	Oss   OssConfig             `mapstructure:"oss" json:"oss"`   // oss 配置
-	Crons []xcron.TaskConfig    `mapstructure:"crons" json:"crons"`
+	Cros      middleware.CrosConfig `mapstructure:"cros" json:"cros"` // 跨域配置
+	Oss       OssConfig             `mapstructure:"oss" json:"oss"`   // oss 配置
+	Crons     []xcron.TaskConfig    `mapstructure:"crons" json:"crons"`
+	Area      AreaConfig            `mapstructure:"area" json:"area"`             // 省市区数据配置
+	RateLimit RateLimitConfig       `mapstructure:"rate_limit" json:"rate_limit"` // 限流配置
+	Timeout   TimeoutConfig         `mapstructure:"timeout" json:"timeout"`       // 超时配置
+
+	ClientVersion ClientVersionConfig    `mapstructure:"client_version" json:"client_version"` // 客户端版本控制配置
+	Features      map[string]FeatureFlag `mapstructure:"features" json:"features"`             // 功能开关，key 为开关名称
+	Maintenance   MaintenanceConfig      `mapstructure:"maintenance" json:"maintenance"`       // 维护模式配置
+	Security      SecurityConfig         `mapstructure:"security" json:"security"`             // 安全响应头、IP 访问控制及可信代理配置
+	APIKey        APIKeyConfig           `mapstructure:"api_key" json:"-"`                     // 合作方 API Key 配置，包含密钥，不输出到 JSON
 }
 
-// Reload 重新加载 BusinessConfig 配置.
+// businessMu 保护热更新时对 BusinessCfg 的整体替换
+var businessMu sync.RWMutex
+
+// Reload 重新加载 BusinessConfig 配置，实现了 xconfig.HotReloadable 接口.
 // business 模块的所有配置项都被认为是安全的，可以进行热更新.
-func (c *BusinessConfig) Reload(v *viper.Viper) {
-	if err := v.UnmarshalKey("business", c); err != nil {
-		fmt.Printf("failed to reload business config: %v\n", err)
-		return
+// 新配置解析到副本并校验通过后才整体替换，失败时继续使用原配置.
+// BusinessCfg 替换后执行 OnReload 注册的回调.
+func (c *BusinessConfig) Reload(v *viper.Viper) error {
+	next := &BusinessConfig{}
+	if err := v.UnmarshalKey("business", next); err != nil {
+		return fmt.Errorf("unmarshal business config: %w", err)
+	}
+	if err := next.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid business config: %w", err)
+	}
+	if err := next.ClientVersion.Validate(); err != nil {
+		return fmt.Errorf("invalid business config: %w", err)
+	}
+	for name, flag := range next.Features {
+		if err := flag.Validate(); err != nil {
+			return fmt.Errorf("invalid business config: feature %s: %w", name, err)
+		}
+	}
+	if err := next.Maintenance.Validate(); err != nil {
+		return fmt.Errorf("invalid business config: %w", err)
+	}
+	if err := next.Security.Validate(); err != nil {
+		return fmt.Errorf("invalid business config: %w", err)
+	}
+	if err := validateCros(next.Cros); err != nil {
+		return fmt.Errorf("invalid business config: %w", err)
+	}
+
+	businessMu.Lock()
+	prev := *c
+	*c = *next
+	businessMu.Unlock()
+	if c == BusinessCfg {
+		notifyReload(prev, *next)
+	}
+	// 配置中有 oss 密钥等敏感信息，只记录发生变化的配置项
+	logger.Info(context.Background(), "CONFIG_RELOAD", "business config reload success, changed: %v", changedSections(prev, *next))
+	return nil
+}
+
+// changedSections 返回 prev、next 中发生变化的配置项名称，如 cros、rate_limit
+func changedSections(prev, next BusinessConfig) []string {
+	var changed []string
+	pv, nv := reflect.ValueOf(prev), reflect.ValueOf(next)
+	for i := 0; i < pv.NumField(); i++ {
+		if !reflect.DeepEqual(pv.Field(i).Interface(), nv.Field(i).Interface()) {
+			changed = append(changed, pv.Type().Field(i).Tag.Get("mapstructure"))
+		}
 	}
-	fmt.Printf("business config reload success, config: %+v\n", c)
+	return changed
+}
+
+// CrosConfig 返回当前生效的跨域配置，可以在热更新期间并发调用
+func (c *BusinessConfig) CrosConfig() middleware.CrosConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.Cros
+}
+
+// TimeoutConfig 返回当前生效的超时配置，可以在热更新期间并发调用
+func (c *BusinessConfig) TimeoutConfig() TimeoutConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.Timeout
+}
+
+// RateLimitConfig 返回当前生效的限流配置，可以在热更新期间并发调用
+func (c *BusinessConfig) RateLimitConfig() RateLimitConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.RateLimit
+}
+
+// ClientVersionConfig 返回当前生效的客户端版本控制配置，可以在热更新期间并发调用
+func (c *BusinessConfig) ClientVersionConfig() ClientVersionConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.ClientVersion
+}
+
+// FeatureFlag 返回当前生效的功能开关，开关不存在时返回 false，可以在热更新期间并发调用
+func (c *BusinessConfig) FeatureFlag(name string) (FeatureFlag, bool) {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	flag, ok := c.Features[name]
+	return flag, ok
+}
+
+// MaintenanceConfig 返回当前生效的维护模式配置，可以在热更新期间并发调用
+func (c *BusinessConfig) MaintenanceConfig() MaintenanceConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.Maintenance
+}
+
+// SecurityConfig 返回当前生效的安全配置，可以在热更新期间并发调用
+func (c *BusinessConfig) SecurityConfig() SecurityConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.Security
+}
+
+// APIKeyConfig 返回当前生效的 API Key 配置，可以在热更新期间并发调用
+func (c *BusinessConfig) APIKeyConfig() APIKeyConfig {
+	businessMu.RLock()
+	defer businessMu.RUnlock()
+	return c.APIKey
+}
+
+// APIKeyConfig 合作方 API Key 配置
+type APIKeyConfig struct {
+	// Pepper 派生 API Key secret 的服务端密钥，不保存在数据库中，为空时不能创建及校验 API Key。
+	// 修改后所有已创建的 API Key 都会失效
+	Pepper string `mapstructure:"pepper" json:"-"`
 }
 
 // OssConfig oss 配置
@@ -39,9 +167,222 @@ type OssConfig struct {
 	Region         string `mapstructure:"region" json:"region"`
 }
 
+// AreaConfig 省市区数据配置，DatasetFile 和 DatasetOssKey 都为空时只使用内置数据
+type AreaConfig struct {
+	DatasetFile     string        `mapstructure:"dataset_file" json:"dataset_file"`         // 本地数据文件路径，优先于 DatasetOssKey
+	DatasetOssKey   string        `mapstructure:"dataset_oss_key" json:"dataset_oss_key"`   // OSS 数据文件，bucket 使用 oss.bucket
+	RefreshInterval time.Duration `mapstructure:"refresh_interval" json:"refresh_interval"` // 检查新版本的间隔，为 0 时只在启动时加载
+}
+
+// TimeoutConfig 超时配置
+type TimeoutConfig struct {
+	Read    time.Duration            `mapstructure:"read" json:"read"`       // http.Server 的 ReadTimeout，修改后需要重启
+	Write   time.Duration            `mapstructure:"write" json:"write"`     // http.Server 的 WriteTimeout，应大于所有接口的超时时间，修改后需要重启
+	Idle    time.Duration            `mapstructure:"idle" json:"idle"`       // http.Server 的 IdleTimeout，修改后需要重启
+	Default time.Duration            `mapstructure:"default" json:"default"` // 接口默认的处理超时时间，为 0 时不限制
+	Groups  map[string]time.Duration `mapstructure:"groups" json:"groups"`   // 路由分组的处理超时时间，如 users = "5s"，未配置的分组使用 Default
+}
+
+// For 返回路由分组的处理超时时间
+func (c TimeoutConfig) For(group string) time.Duration {
+	if d, ok := c.Groups[group]; ok {
+		return d
+	}
+	return c.Default
+}
+
+// 限流维度
+//
+// api_key、user 维度按认证通过的调用方计数，只在挂载了 RateLimiter.AuthenticatedMiddleware 的路由分组上生效
+const (
+	RateLimitByIP     = "ip"      // 客户端 IP
+	RateLimitByUser   = "user"    // 登录用户，未登录时按 IP；目前没有设置用户 ID 的登录认证，实际按 IP 计数
+	RateLimitByAPIKey = "api_key" // 签名校验通过的 API Key，未认证时按 IP
+	RateLimitByRoute  = "route"   // 路由，所有客户端共享
+)
+
+// 限流算法
+const (
+	RateLimitSlidingWindow = "sliding_window" // 滑动窗口，Window 内最多 Limit 次
+	RateLimitTokenBucket   = "token_bucket"   // 令牌桶，容量为 Limit，每个 Window 补满
+)
+
+// RateLimitConfig 限流配置
+type RateLimitConfig struct {
+	Enabled bool            `mapstructure:"enabled" json:"enabled"` // 是否启用
+	Rules   []RateLimitRule `mapstructure:"rules" json:"rules"`     // 限流规则，一个请求命中多条规则时需要全部通过
+}
+
+// RateLimitRule 限流规则
+type RateLimitRule struct {
+	Name      string        `mapstructure:"name" json:"name"`           // 规则名称，同名规则共享计数
+	Paths     []string      `mapstructure:"paths" json:"paths"`         // 生效的路由，如 /api/v1/users，以 * 结尾时按前缀匹配，为空时对所有路由生效
+	Methods   []string      `mapstructure:"methods" json:"methods"`     // 生效的请求方法，为空时对所有方法生效
+	KeyBy     string        `mapstructure:"key_by" json:"key_by"`       // 限流维度：ip、user、api_key、route
+	Algorithm string        `mapstructure:"algorithm" json:"algorithm"` // 限流算法：sliding_window、token_bucket，默认 sliding_window
+	Limit     int           `mapstructure:"limit" json:"limit"`         // Window 内允许的请求数
+	Window    time.Duration `mapstructure:"window" json:"window"`       // 时间窗口
+}
+
+// Validate 校验限流规则
+func (c RateLimitConfig) Validate() error {
+	for i, rule := range c.Rules {
+		switch {
+		case rule.Name == "":
+			return fmt.Errorf("rate limit rule %d: name is empty", i)
+		case rule.Limit <= 0 || rule.Window <= 0:
+			return fmt.Errorf("rate limit rule %s: limit and window must be positive", rule.Name)
+		}
+		switch rule.KeyBy {
+		case RateLimitByIP, RateLimitByUser, RateLimitByAPIKey, RateLimitByRoute:
+		default:
+			return fmt.Errorf("rate limit rule %s: unknown key_by %q", rule.Name, rule.KeyBy)
+		}
+		switch rule.Algorithm {
+		case "", RateLimitSlidingWindow, RateLimitTokenBucket:
+		default:
+			return fmt.Errorf("rate limit rule %s: unknown algorithm %q", rule.Name, rule.Algorithm)
+		}
+	}
+	return nil
+}
+
+// ClientVersionConfig 客户端版本控制配置
+type ClientVersionConfig struct {
+	Enabled   bool                       `mapstructure:"enabled" json:"enabled"`     // 是否启用
+	Platforms map[string]PlatformVersion `mapstructure:"platforms" json:"platforms"` // 各平台的版本要求，key 为平台，如 ios、android，未配置的平台不做限制
+}
+
+// PlatformVersion 一个平台的版本要求，版本号格式为 主版本.次版本.修订号，如 3.2.1
+type PlatformVersion struct {
+	MinVersion        string `mapstructure:"min_version" json:"min_version"`               // 最低版本，低于该版本时强制升级
+	DeprecatedVersion string `mapstructure:"deprecated_version" json:"deprecated_version"` // 低于该版本时提示升级，不影响请求
+	UpgradeURL        string `mapstructure:"upgrade_url" json:"upgrade_url"`               // 升级地址，强制升级时返回给客户端
+}
+
+// Validate 校验版本号格式
+func (c ClientVersionConfig) Validate() error {
+	for platform, v := range c.Platforms {
+		for name, version := range map[string]string{"min_version": v.MinVersion, "deprecated_version": v.DeprecatedVersion} {
+			if _, ok := CanonicalVersion(version); version != "" && !ok {
+				return fmt.Errorf("client version %s: invalid %s %q", platform, name, version)
+			}
+		}
+	}
+	return nil
+}
+
+// CanonicalVersion 把 3.2.1、v3.2 等版本号转换为 semver 可以比较的格式，格式错误时返回 false
+func CanonicalVersion(version string) (string, bool) {
+	v := "v" + strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if !semver.IsValid(v) {
+		return "", false
+	}
+	return v, true
+}
+
+// FeatureFlag 功能开关
+//
+// Enabled 为 false 时对所有请求关闭；为 true 时，UserIDs 中的用户及 Allowlist 中的设备始终打开，
+// 其余请求按 Percentage 灰度，Percentage 为 0 时只对名单打开，为 100 时对所有请求打开
+type FeatureFlag struct {
+	Enabled    bool     `mapstructure:"enabled" json:"enabled"`       // 总开关
+	Percentage int      `mapstructure:"percentage" json:"percentage"` // 灰度比例，0~100，按用户 ID 或设备 ID 分桶，同一用户的结果稳定，全量打开时设置为 100
+	UserIDs    []string `mapstructure:"user_ids" json:"user_ids"`     // 始终打开的用户 ID
+	Allowlist  []string `mapstructure:"allowlist" json:"allowlist"`   // 始终打开的设备 ID，如测试设备
+}
+
+// Validate 校验功能开关
+func (f FeatureFlag) Validate() error {
+	if f.Percentage < 0 || f.Percentage > 100 {
+		return fmt.Errorf("percentage %d out of range 0~100", f.Percentage)
+	}
+	return nil
+}
+
+// MaintenanceConfig 维护模式配置，开启后除健康检查及 AllowIPs 外的请求都返回 503
+type MaintenanceConfig struct {
+	Enabled    bool          `mapstructure:"enabled" json:"enabled"`         // 是否开启维护模式
+	Message    string        `mapstructure:"message" json:"message"`         // 返回给客户端的提示，为空时使用默认提示
+	RetryAfter time.Duration `mapstructure:"retry_after" json:"retry_after"` // 预计恢复时间，设置 Retry-After 响应头，为 0 时不设置
+	AllowIPs   []string      `mapstructure:"allow_ips" json:"allow_ips"`     // 维护期间允许访问的 IP 或 CIDR，如内网及测试人员
+}
+
+// Validate 校验维护模式配置
+func (c MaintenanceConfig) Validate() error {
+	if err := validateIPs(c.AllowIPs); err != nil {
+		return fmt.Errorf("maintenance: invalid allow ip %w", err)
+	}
+	return nil
+}
+
+// SecurityConfig 安全配置
+type SecurityConfig struct {
+	// TrustedProxies 可信代理的 IP 或 CIDR，只有直连地址在列表中时才从 X-Forwarded-For 中取客户端 IP，
+	// 为空时不信任任何代理，客户端 IP 为直连地址。修改后需要重启
+	TrustedProxies []string                `mapstructure:"trusted_proxies" json:"trusted_proxies"`
+	Headers        SecurityHeadersConfig   `mapstructure:"headers" json:"headers"`   // 安全响应头
+	IPRules        map[string]IPRuleConfig `mapstructure:"ip_rules" json:"ip_rules"` // 路由分组的 IP 访问控制，key 为分组名称，如 system、partner，未配置的分组不限制
+}
+
+// SecurityHeadersConfig 安全响应头配置，为空的项不设置对应的响应头
+type SecurityHeadersConfig struct {
+	HSTSMaxAge            time.Duration `mapstructure:"hsts_max_age" json:"hsts_max_age"`                       // Strict-Transport-Security 的 max-age，为 0 时不设置，浏览器只在 HTTPS 响应中使用
+	HSTSIncludeSubdomains bool          `mapstructure:"hsts_include_subdomains" json:"hsts_include_subdomains"` // HSTS 是否包含子域名
+	HSTSPreload           bool          `mapstructure:"hsts_preload" json:"hsts_preload"`                       // HSTS 是否加入 preload 列表
+	ContentSecurityPolicy string        `mapstructure:"content_security_policy" json:"content_security_policy"` // Content-Security-Policy
+	ContentTypeNosniff    bool          `mapstructure:"content_type_nosniff" json:"content_type_nosniff"`       // 是否设置 X-Content-Type-Options: nosniff
+	ReferrerPolicy        string        `mapstructure:"referrer_policy" json:"referrer_policy"`                 // Referrer-Policy，如 no-referrer
+	FrameOptions          string        `mapstructure:"frame_options" json:"frame_options"`                     // X-Frame-Options：DENY、SAMEORIGIN
+}
+
+// IPRuleConfig 一个路由分组的 IP 访问控制，列表项可以是 IP 或 CIDR
+//
+// 在 Deny 中的 IP 拒绝访问；Allow 不为空时，只有 Allow 中的 IP 可以访问
+type IPRuleConfig struct {
+	Allow []string `mapstructure:"allow" json:"allow"` // 允许访问的 IP
+	Deny  []string `mapstructure:"deny" json:"deny"`   // 拒绝访问的 IP，优先于 Allow
+}
+
+// Validate 校验安全配置
+func (c SecurityConfig) Validate() error {
+	if err := validateIPs(c.TrustedProxies); err != nil {
+		return fmt.Errorf("security: invalid trusted proxy %w", err)
+	}
+	if c.Headers.HSTSMaxAge < 0 {
+		return fmt.Errorf("security: hsts_max_age must not be negative")
+	}
+	switch strings.ToUpper(c.Headers.FrameOptions) {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		return fmt.Errorf("security: unknown frame_options %q", c.Headers.FrameOptions)
+	}
+	for group, rule := range c.IPRules {
+		if err := validateIPs(rule.Allow); err != nil {
+			return fmt.Errorf("security: ip rule %s: invalid allow ip %w", group, err)
+		}
+		if err := validateIPs(rule.Deny); err != nil {
+			return fmt.Errorf("security: ip rule %s: invalid deny ip %w", group, err)
+		}
+	}
+	return nil
+}
+
+// validateIPs 校验 IP 或 CIDR 列表，返回的错误为第一个格式错误的项
+func validateIPs(ips []string) error {
+	for _, ip := range ips {
+		if _, _, err := net.ParseCIDR(ip); err != nil && net.ParseIP(ip) == nil {
+			return fmt.Errorf("%q", ip)
+		}
+	}
+	return nil
+}
+
 // BusinessCfg 业务配置，注册为全局变量，方便使用
 var BusinessCfg = &BusinessConfig{}
 
+var _ xconfig.HotReloadable = (*BusinessConfig)(nil)
+
 func init() {
 	xconfig.Register("business", BusinessCfg)
 }
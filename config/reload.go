@@ -0,0 +1,68 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jessewkun/gocommon/middleware"
+	"github.com/jessewkun/gocommon/safego"
+)
+
+// ReloadFunc BusinessCfg 热更新后的回调，prev、next 分别为更新前后的配置
+type ReloadFunc func(prev, next BusinessConfig)
+
+type subscriber struct {
+	id int
+	fn ReloadFunc
+}
+
+var (
+	subscribersMu sync.Mutex
+	subscribers   []subscriber
+	nextSubscribe int
+)
+
+// OnReload 注册 BusinessCfg 热更新后的回调，返回的函数用于取消注册
+//
+// 回调在配置替换后按注册顺序同步执行，回调中的 panic 只记录日志，不影响其他回调及配置更新。
+// 每个请求都读取配置的中间件不需要注册回调，适用于根据配置创建了对象、配置变化后需要重建的场景
+func OnReload(fn ReloadFunc) (cancel func()) {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	id := nextSubscribe
+	nextSubscribe++
+	subscribers = append(subscribers, subscriber{id: id, fn: fn})
+	return func() {
+		subscribersMu.Lock()
+		defer subscribersMu.Unlock()
+		for i, s := range subscribers {
+			if s.id == id {
+				subscribers = append(subscribers[:i:i], subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notifyReload 依次执行热更新回调
+func notifyReload(prev, next BusinessConfig) {
+	subscribersMu.Lock()
+	current := subscribers
+	subscribersMu.Unlock()
+
+	for _, s := range current {
+		safego.SafeGo(context.Background(), func() { s.fn(prev, next) })
+	}
+}
+
+// validateCros 校验跨域配置，gin-contrib/cors 在配置错误时 panic，这里转换为错误，避免热更新时 panic
+func validateCros(c middleware.CrosConfig) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cros: %v", r)
+		}
+	}()
+	middleware.Cros(c)
+	return nil
+}
@@ -2,9 +2,12 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"godemo/config"
 	"os"
 	"os/signal"
+	"runtime/debug"
 	"sync"
 	"syscall"
 	"time"
@@ -13,6 +16,16 @@ import (
 	"github.com/jessewkun/gocommon/logger"
 )
 
+// Process exit codes for a failed App.Run, see ExitCode.
+// Other startup failures, such as loading the configuration, exit with 1.
+const (
+	ExitServerFailed = 2 // a server failed to start or stop, e.g. the port is already in use
+	ExitServerPanic  = 3 // a server panicked
+)
+
+// ErrServerPanic is wrapped by the error of a server that panicked in Start or Stop.
+var ErrServerPanic = errors.New("server panicked")
+
 // App is the central structure of an application. It manages the lifecycle of servers.
 type App struct {
 	name    string
@@ -20,6 +33,10 @@ type App struct {
 	servers []Server
 	mu      sync.Mutex
 	cancel  func()
+
+	starting sync.WaitGroup // goroutines running Server.Start
+	failed   []bool         // servers whose Start failed, indexed like servers, they are not stopped
+	errs     []error        // ServerErrors collected during Run
 }
 
 // NewApp creates a new App instance. It initializes options and the logger.
@@ -47,24 +64,48 @@ func (a *App) AddServer(s ...Server) {
 
 // Run starts the application, including all registered servers, and waits for a
 // shutdown signal. It honors the lifecycle of the provided parent context.
+//
+// If a server fails to start or panics, the application shuts down the other servers.
+// Run returns the failures of all servers joined, each one a *ServerError, or nil
+// after a graceful shutdown.
 func (a *App) Run(ctx context.Context) error {
 	appCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	a.cancel = cancel
 
 	a.startServers(appCtx)
 	a.waitForShutdown(appCtx)
 	a.stopServers()
 
-	return nil
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return errors.Join(a.errs...)
+}
+
+// ExitCode returns the process exit code for the error returned by Run.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, ErrServerPanic):
+		return ExitServerPanic
+	default:
+		return ExitServerFailed
+	}
 }
 
 // startServers starts all registered servers in separate goroutines.
+// A server that fails to start cancels the application context.
 func (a *App) startServers(ctx context.Context) {
-	for _, s := range a.servers {
-		srv := s
+	a.failed = make([]bool, len(a.servers))
+	for i, s := range a.servers {
+		i, srv := i, s
+		a.starting.Add(1)
 		go func() {
-			if err := srv.Start(ctx); err != nil {
-				a.log(ctx, "APP_START_ERROR", "Application '%s' failed to start server: %v", a.name, err)
+			defer a.starting.Done()
+			if err := a.call(ctx, srv, srv.Start); err != nil {
+				a.fail(i, &ServerError{Server: serverName(srv), Op: "start", Err: err})
+				a.log(ctx, "APP_START_ERROR", "Application '%s' failed to start server %s: %v", a.name, serverName(srv), err)
 				a.cancel()
 			}
 		}()
@@ -91,21 +132,65 @@ func (a *App) stopServers() {
 	defer shutdownCancel()
 
 	var wg sync.WaitGroup
-	for _, s := range a.servers {
+	for i, s := range a.servers {
+		if a.hasFailed(i) {
+			continue
+		}
 		wg.Add(1)
 		srv := s
 		go func() {
 			defer wg.Done()
-			if err := srv.Stop(shutdownCtx); err != nil {
-				a.log(shutdownCtx, "SERVER_STOP_ERROR", "Failed to stop server gracefully: %v", err)
+			if err := a.call(shutdownCtx, srv, srv.Stop); err != nil {
+				a.fail(-1, &ServerError{Server: serverName(srv), Op: "stop", Err: err})
+				a.log(shutdownCtx, "SERVER_STOP_ERROR", "Failed to stop server %s gracefully: %v", serverName(srv), err)
 			}
 		}()
 	}
 	wg.Wait()
 
+	// Blocking servers return from Start once stopped, wait for them to report errors.
+	started := make(chan struct{})
+	go func() {
+		a.starting.Wait()
+		close(started)
+	}()
+	select {
+	case <-started:
+	case <-shutdownCtx.Done():
+		a.log(shutdownCtx, "SERVER_STOP_ERROR", "Timed out waiting for servers of application '%s' to return from Start", a.name)
+	}
+
 	a.log(context.Background(), "APP_SHUTDOWN", "Application '%s' gracefully shut down.", a.name)
 }
 
+// call runs fn, a Start or Stop of srv, and converts a panic into an error wrapping ErrServerPanic,
+// so that a panicking server shuts the application down instead of crashing the process.
+func (a *App) call(ctx context.Context, srv Server, fn func(context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			a.log(ctx, "SERVER_PANIC", "Server %s panicked: %v\n%s", serverName(srv), r, debug.Stack())
+			err = fmt.Errorf("%w: %v", ErrServerPanic, r)
+		}
+	}()
+	return fn(ctx)
+}
+
+// fail records the error of a server, i is the index of a server that failed to start, or -1.
+func (a *App) fail(i int, err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if i >= 0 {
+		a.failed[i] = true
+	}
+	a.errs = append(a.errs, err)
+}
+
+func (a *App) hasFailed(i int) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.failed[i]
+}
+
 // Log is a helper function for consistent logging.
 // 这个函数是为了在非 debug 模式下，能够自动感知到服务器的启动和关闭，避免出现服务发布但是实际没有启动或启动失败，开发人员还不知道的情况。
 func (a *App) log(c context.Context, tag string, msg string, args ...interface{}) {
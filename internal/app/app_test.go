@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer Start 执行 start，未设置时阻塞到 Stop 被调用
+type fakeServer struct {
+	name    string
+	start   func() error
+	stopped chan struct{}
+}
+
+func newFakeServer(name string, start func() error) *fakeServer {
+	return &fakeServer{name: name, start: start, stopped: make(chan struct{})}
+}
+
+func (s *fakeServer) Name() string { return s.name }
+
+func (s *fakeServer) Start(ctx context.Context) error {
+	if s.start != nil {
+		return s.start()
+	}
+	<-s.stopped
+	return nil
+}
+
+func (s *fakeServer) Stop(ctx context.Context) error {
+	close(s.stopped)
+	return nil
+}
+
+func TestAppRun(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	tests := []struct {
+		name     string
+		start    func() error
+		wantErr  string
+		wantCode int
+	}{
+		{name: "启动失败", start: func() error { return errors.New("address already in use") }, wantErr: "start server failing: address already in use", wantCode: ExitServerFailed},
+		{name: "启动 panic", start: func() error { panic("boom") }, wantErr: "start server failing: server panicked: boom", wantCode: ExitServerPanic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy := newFakeServer("healthy", nil)
+			failing := newFakeServer("failing", tt.start)
+			a := &App{name: "test"}
+			a.AddServer(healthy, failing)
+
+			err := a.Run(context.Background())
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+			assert.Equal(t, tt.wantCode, ExitCode(err))
+
+			var serverErr *ServerError
+			require.True(t, errors.As(err, &serverErr))
+			assert.Equal(t, "failing", serverErr.Server)
+
+			// 其他服务被停止，启动失败的服务不再调用 Stop
+			select {
+			case <-healthy.stopped:
+			default:
+				t.Fatal("healthy server not stopped")
+			}
+			select {
+			case <-failing.stopped:
+				t.Fatal("failing server stopped")
+			default:
+			}
+		})
+	}
+}
+
+func TestAppRunGracefulShutdown(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	a := &App{name: "test"}
+	a.AddServer(newFakeServer("healthy", nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := a.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, ExitCode(err))
+}
@@ -1,7 +1,10 @@
 // Package app provides a framework for building applications with a consistent lifecycle.
 package app
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
 // Server defines the interface for a runnable service that can be managed by an App.
 type Server interface {
@@ -12,3 +15,32 @@ type Server interface {
 	// Stop gracefully shuts down the server, with a deadline provided by the context.
 	Stop(ctx context.Context) error
 }
+
+// Named can be implemented by a Server to identify it in logs and errors.
+// Servers that do not implement it are identified by their type.
+type Named interface {
+	Name() string
+}
+
+// serverName returns the identity of a server used in logs and errors.
+func serverName(s Server) string {
+	if n, ok := s.(Named); ok {
+		return n.Name()
+	}
+	return fmt.Sprintf("%T", s)
+}
+
+// ServerError is returned by App.Run when a server fails to start or stop.
+type ServerError struct {
+	Server string // identity of the server, see Named
+	Op     string // "start" or "stop"
+	Err    error
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("%s server %s: %v", e.Op, e.Server, e.Err)
+}
+
+func (e *ServerError) Unwrap() error {
+	return e.Err
+}
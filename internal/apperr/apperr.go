@@ -0,0 +1,191 @@
+// Package apperr 定义应用错误及其到 HTTP 响应的映射
+//
+// service 层返回 *Error 描述业务失败的原因，handler 和中间件通过 Render 统一输出为 response 信封，
+// 非 *Error 的错误一律视为内部错误，原始信息只记录日志，不返回给客户端
+package apperr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Kind 错误类型，决定响应的 HTTP 状态码
+type Kind int
+
+const (
+	KindInternal        Kind = iota // 内部错误
+	KindValidation                  // 参数错误
+	KindUnauthorized                // 未登录或凭证无效
+	KindForbidden                   // 没有权限
+	KindNotFound                    // 资源不存在
+	KindConflict                    // 资源冲突，如重复创建
+	KindRateLimited                 // 请求过于频繁
+	KindTooLarge                    // 请求体过大
+	KindUnprocessable               // 请求格式正确但无法处理，如复用 Idempotency-Key 提交了不同的请求
+	KindTimeout                     // 请求处理超时
+	KindUnavailable                 // 服务暂不可用，如请求在处理前已被取消
+	KindUpgradeRequired             // 客户端版本过低，需要升级
+)
+
+// 各错误类型对应的 HTTP 状态码
+var kindStatus = map[Kind]int{
+	KindInternal:        http.StatusInternalServerError,
+	KindValidation:      http.StatusBadRequest,
+	KindUnauthorized:    http.StatusUnauthorized,
+	KindForbidden:       http.StatusForbidden,
+	KindNotFound:        http.StatusNotFound,
+	KindConflict:        http.StatusConflict,
+	KindRateLimited:     http.StatusTooManyRequests,
+	KindTooLarge:        http.StatusRequestEntityTooLarge,
+	KindUnprocessable:   http.StatusUnprocessableEntity,
+	KindTimeout:         http.StatusGatewayTimeout,
+	KindUnavailable:     http.StatusServiceUnavailable,
+	KindUpgradeRequired: http.StatusUpgradeRequired,
+}
+
+// Error 应用错误
+type Error struct {
+	Kind    Kind
+	Code    int         // 业务错误码，发布后保持不变，客户端据此判断错误
+	Message string      // 返回给客户端的提示信息
+	Data    interface{} // 附加数据，输出到信封的 data 中，如参数错误时逐个字段的错误提示
+	Err     error       // 原始错误，只用于日志
+}
+
+// New 创建应用错误
+func New(kind Kind, code int, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// NotFound 创建资源不存在错误
+func NotFound(code int, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+// Conflict 创建资源冲突错误
+func Conflict(code int, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+// Validation 创建参数错误
+func Validation(code int, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+// Unauthorized 创建未登录错误
+func Unauthorized(code int, message string) *Error {
+	return New(KindUnauthorized, code, message)
+}
+
+// Forbidden 创建没有权限错误
+func Forbidden(code int, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+// RateLimited 创建请求过于频繁错误
+func RateLimited(code int, message string) *Error {
+	return New(KindRateLimited, code, message)
+}
+
+// TooLarge 创建请求体过大错误
+func TooLarge(code int, message string) *Error {
+	return New(KindTooLarge, code, message)
+}
+
+// Unprocessable 创建无法处理的请求错误
+func Unprocessable(code int, message string) *Error {
+	return New(KindUnprocessable, code, message)
+}
+
+// Timeout 创建请求处理超时错误
+func Timeout(code int, message string) *Error {
+	return New(KindTimeout, code, message)
+}
+
+// Unavailable 创建服务暂不可用错误
+func Unavailable(code int, message string) *Error {
+	return New(KindUnavailable, code, message)
+}
+
+// UpgradeRequired 创建客户端需要升级错误
+func UpgradeRequired(code int, message string) *Error {
+	return New(KindUpgradeRequired, code, message)
+}
+
+// Internal 创建内部错误
+func Internal(code int, message string) *Error {
+	return New(KindInternal, code, message)
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap 实现 errors.Unwrap 接口
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is 错误码相同即认为是同一个错误，使 errors.Is(err, ErrUserNotFound) 不受 Wrap、WithMessage 影响
+func (e *Error) Is(target error) bool {
+	var t *Error
+	return errors.As(target, &t) && t.Code == e.Code
+}
+
+// Status 返回错误对应的 HTTP 状态码
+func (e *Error) Status() int {
+	if status, ok := kindStatus[e.Kind]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// Wrap 返回附带原始错误的副本，预定义的错误本身不会被修改
+func (e *Error) Wrap(err error) *Error {
+	c := *e
+	c.Err = err
+	return &c
+}
+
+// WithMessage 返回替换了提示信息的副本
+func (e *Error) WithMessage(message string) *Error {
+	c := *e
+	c.Message = message
+	return &c
+}
+
+// WithData 返回附带数据的副本
+func (e *Error) WithData(data interface{}) *Error {
+	c := *e
+	c.Data = data
+	return &c
+}
+
+// From 从错误链中取出 *Error，取不到时包装为内部错误
+//
+// 内部错误的错误链中包含 context.DeadlineExceeded 时转换为 ErrTimeout，包含 context.Canceled 时转换为 ErrUnavailable，
+// 这样仓储层因请求超时返回的错误经过 service 包装为 ErrInternal 后仍然输出 504/503
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) && appErr.Kind != KindInternal {
+		return appErr
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrTimeout.Wrap(err)
+	case errors.Is(err, context.Canceled):
+		return ErrUnavailable.Wrap(err)
+	case appErr != nil:
+		return appErr
+	}
+	return ErrInternal.Wrap(err)
+}
+
+// BindError 把请求参数绑定或校验失败的错误转换为参数错误，提示信息中保留具体原因
+func BindError(err error) *Error {
+	return ErrValidation.WithMessage(err.Error()).Wrap(err)
+}
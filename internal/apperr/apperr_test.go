@@ -0,0 +1,186 @@
+package apperr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/jessewkun/gocommon/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+	// 测试中没有初始化日志
+	logger.Cfg.Closed = true
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		code    int
+		message string
+	}{
+		{name: "资源不存在", err: ErrUserNotFound, status: http.StatusNotFound, code: CodeUserNotFound, message: "用户不存在"},
+		{name: "资源冲突", err: ErrUsernameTaken.Wrap(errors.New("Error 1062: Duplicate entry")), status: http.StatusConflict, code: CodeUsernameTaken, message: "用户名已存在"},
+		{name: "参数错误", err: BindError(errors.New("username is required")), status: http.StatusBadRequest, code: CodeValidation, message: "username is required"},
+		{name: "未登录", err: ErrUnauthorized, status: http.StatusUnauthorized, code: CodeUnauthorized, message: "未登录或登录已过期"},
+		{name: "没有权限", err: ErrForbidden, status: http.StatusForbidden, code: CodeForbidden, message: "没有权限"},
+		{name: "强制升级", err: ErrForceUpgrade, status: http.StatusUpgradeRequired, code: CodeForceUpgrade, message: "当前版本过低，请升级到最新版本"},
+		{name: "限流", err: ErrRateLimited, status: http.StatusTooManyRequests, code: CodeRateLimited, message: "请求过于频繁，请稍后重试"},
+		{name: "多层包装", err: fmt.Errorf("create user: %w", ErrUsernameTaken), status: http.StatusConflict, code: CodeUsernameTaken, message: "用户名已存在"},
+		{name: "请求超时", err: ErrInternal.Wrap(fmt.Errorf("query users: %w", context.DeadlineExceeded)), status: http.StatusGatewayTimeout, code: CodeTimeout, message: "请求处理超时，请稍后重试"},
+		{name: "请求取消", err: context.Canceled, status: http.StatusServiceUnavailable, code: CodeUnavailable, message: "服务暂不可用，请稍后重试"},
+		{name: "业务错误不受超时影响", err: ErrUsernameTaken.Wrap(context.DeadlineExceeded), status: http.StatusConflict, code: CodeUsernameTaken, message: "用户名已存在"},
+		{name: "未知错误不泄露原始信息", err: errors.New("Error 1146: Table 'users' doesn't exist"), status: http.StatusInternalServerError, code: CodeInternal, message: "系统错误，请稍后重试"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+			c.Set(string(constant.CtxTraceID), "trace-1")
+
+			Abort(c, tt.err)
+
+			assert.True(t, c.IsAborted())
+			assert.Equal(t, tt.status, w.Code)
+			var result response.APIResult
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+			assert.Equal(t, tt.code, result.Code)
+			assert.Equal(t, tt.message, result.Message)
+			assert.Equal(t, "trace-1", result.TraceID)
+		})
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	cause := errors.New("record not found")
+	err := fmt.Errorf("find user: %w", ErrUserNotFound.WithMessage("用户 1 不存在").Wrap(cause))
+
+	assert.True(t, errors.Is(err, ErrUserNotFound))
+	assert.True(t, errors.Is(err, cause))
+	assert.False(t, errors.Is(err, ErrNotFound))
+	// 预定义错误不会被 Wrap、WithMessage 修改
+	assert.Nil(t, ErrUserNotFound.Err)
+	assert.Equal(t, "用户不存在", ErrUserNotFound.Message)
+}
+
+func TestRenderProblem(t *testing.T) {
+	fields := []map[string]string{{"field": "Email", "json_name": "email", "rule": "email", "message": "email必须是一个有效的邮箱"}}
+	validationErr := ErrValidation.WithMessage("email必须是一个有效的邮箱").WithData(gin.H{"errors": fields})
+
+	tests := []struct {
+		name        string
+		accept      string
+		err         error
+		contentType string
+		expected    map[string]interface{}
+	}{
+		{
+			name:        "未指定Accept返回默认信封",
+			err:         ErrUserNotFound,
+			contentType: "application/json; charset=utf-8",
+			expected:    map[string]interface{}{"code": float64(CodeUserNotFound), "message": "用户不存在", "data": map[string]interface{}{}, "trace_id": "trace-1"},
+		},
+		{
+			name:        "通配符返回默认信封",
+			accept:      "*/*",
+			err:         ErrUserNotFound,
+			contentType: "application/json; charset=utf-8",
+			expected:    map[string]interface{}{"code": float64(CodeUserNotFound), "message": "用户不存在", "data": map[string]interface{}{}, "trace_id": "trace-1"},
+		},
+		{
+			name:        "problem+json",
+			accept:      "application/problem+json",
+			err:         ErrUserNotFound,
+			contentType: MIMEProblemJSON,
+			expected: map[string]interface{}{
+				"type":     "/problems/10101",
+				"title":    "Not Found",
+				"status":   float64(http.StatusNotFound),
+				"detail":   "用户不存在",
+				"instance": "/api/v1/users",
+				"code":     float64(CodeUserNotFound),
+				"trace_id": "trace-1",
+			},
+		},
+		{
+			name:        "参数错误的扩展成员",
+			accept:      "application/problem+json, application/json;q=0.9",
+			err:         validationErr,
+			contentType: MIMEProblemJSON,
+			expected: map[string]interface{}{
+				"type":     "/problems/1001",
+				"title":    "Bad Request",
+				"status":   float64(http.StatusBadRequest),
+				"detail":   "email必须是一个有效的邮箱",
+				"instance": "/api/v1/users",
+				"code":     float64(CodeValidation),
+				"trace_id": "trace-1",
+				"errors": []interface{}{
+					map[string]interface{}{"field": "Email", "json_name": "email", "rule": "email", "message": "email必须是一个有效的邮箱"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users", nil)
+			if tt.accept != "" {
+				c.Request.Header.Set("Accept", tt.accept)
+			}
+			c.Set(string(constant.CtxTraceID), "trace-1")
+
+			Render(c, tt.err)
+
+			assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			var got map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestWantsProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{name: "未指定", accept: "", want: false},
+		{name: "只要求 problem+json", accept: "application/problem+json", want: true},
+		{name: "problem+json 的 q 值更低", accept: "application/problem+json;q=0.1, application/json", want: false},
+		{name: "problem+json 的 q 值更高", accept: "application/json;q=0.5, application/problem+json", want: true},
+		{name: "q 值相同", accept: "application/json, application/problem+json", want: false},
+		{name: "低于通配符", accept: "application/problem+json;q=0.5, */*", want: false},
+		{name: "高于通配符", accept: "application/problem+json, */*;q=0.8", want: true},
+		{name: "带其他参数", accept: "application/problem+json; charset=utf-8; q=0.9, application/*;q=0.5", want: true},
+		{name: "q 值为 0", accept: "application/problem+json;q=0", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+			if tt.accept != "" {
+				c.Request.Header.Set("Accept", tt.accept)
+			}
+			assert.Equal(t, tt.want, wantsProblem(c))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package apperr
+
+// 通用错误码，与 gocommon/response 中预定义的系统错误码保持一致
+const (
+	CodeInternal     = 1000 // 系统错误
+	CodeValidation   = 1001 // 参数错误
+	CodeForbidden    = 1002 // 没有权限
+	CodeNotFound     = 1003 // 资源不存在
+	CodeRateLimited  = 1004 // 请求过于频繁
+	CodeUnauthorized = 1006 // 未登录或凭证无效
+	CodeConflict     = 1007 // 资源冲突
+	CodeTooLarge     = 1008 // 请求体过大
+
+	CodeIdempotencyInFlight = 1009 // 相同 Idempotency-Key 的请求正在处理中
+	CodeIdempotencyMismatch = 1010 // Idempotency-Key 已用于不同的请求
+	CodeTimeout             = 1011 // 请求处理超时
+	CodeUnavailable         = 1012 // 服务暂不可用
+	CodeAPIKeyInvalid       = 1013 // API Key 不存在、已停用或已过期
+	CodeSignatureInvalid    = 1014 // 请求签名错误
+	CodeRequestReplayed     = 1015 // 请求时间戳超出允许范围或 nonce 已使用
+	CodeForceUpgrade        = 1016 // 客户端版本过低，需要强制升级
+	CodeMaintenance         = 1017 // 系统维护中
+	CodeIPForbidden         = 1018 // 客户端 IP 不允许访问
+)
+
+// 用户模块错误码
+const (
+	CodeUserNotFound  = 10101 // 用户不存在
+	CodeUsernameTaken = 10102 // 用户名已存在
+)
+
+// 通用错误
+var (
+	ErrInternal     = Internal(CodeInternal, "系统错误，请稍后重试")
+	ErrValidation   = Validation(CodeValidation, "参数错误")
+	ErrUnauthorized = Unauthorized(CodeUnauthorized, "未登录或登录已过期")
+	ErrForbidden    = Forbidden(CodeForbidden, "没有权限")
+	ErrNotFound     = NotFound(CodeNotFound, "资源不存在")
+	ErrConflict     = Conflict(CodeConflict, "资源冲突")
+	ErrRateLimited  = RateLimited(CodeRateLimited, "请求过于频繁，请稍后重试")
+	ErrTooLarge     = TooLarge(CodeTooLarge, "请求体过大")
+
+	ErrIdempotencyInFlight = Conflict(CodeIdempotencyInFlight, "相同 Idempotency-Key 的请求正在处理中，请稍后重试")
+	ErrIdempotencyMismatch = Unprocessable(CodeIdempotencyMismatch, "Idempotency-Key 已用于不同的请求")
+	ErrTimeout             = Timeout(CodeTimeout, "请求处理超时，请稍后重试")
+	ErrUnavailable         = Unavailable(CodeUnavailable, "服务暂不可用，请稍后重试")
+	ErrAPIKeyInvalid       = Unauthorized(CodeAPIKeyInvalid, "API Key 无效、已停用或已过期")
+	ErrSignatureInvalid    = Unauthorized(CodeSignatureInvalid, "请求签名错误")
+	ErrRequestReplayed     = Unauthorized(CodeRequestReplayed, "请求已过期或重复提交")
+	ErrForceUpgrade        = UpgradeRequired(CodeForceUpgrade, "当前版本过低，请升级到最新版本")
+	ErrMaintenance         = Unavailable(CodeMaintenance, "系统维护中，请稍后再试")
+	ErrIPForbidden         = Forbidden(CodeIPForbidden, "当前 IP 不允许访问")
+)
+
+// 用户模块错误
+var (
+	ErrUserNotFound  = NotFound(CodeUserNotFound, "用户不存在")
+	ErrUsernameTaken = Conflict(CodeUsernameTaken, "用户名已存在")
+)
@@ -0,0 +1,91 @@
+package apperr
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/constant"
+)
+
+// MIMEProblemJSON RFC 7807 problem details 的媒体类型
+const MIMEProblemJSON = "application/problem+json"
+
+// ProblemTypeBase problem 的 type 前缀，type 为前缀加业务错误码，如 /problems/10102
+var ProblemTypeBase = "/problems/"
+
+// Problem RFC 7807 problem details
+//
+// 标准成员之外的 code、trace_id 以及 Error.Data 中的键值作为扩展成员输出
+type Problem map[string]interface{}
+
+// wantsProblem 客户端在 Accept 中明确要求 problem+json，且 q 值高于 application/json 时返回 true，
+// 未指定、使用通配符或 q 值相同时仍然返回默认的 response 信封
+func wantsProblem(c *gin.Context) bool {
+	qualities := map[string]float64{}
+	for _, part := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if v, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if _, ok := qualities[mediaType]; mediaType != "" && !ok {
+			qualities[mediaType] = q
+		}
+	}
+
+	problemQ, ok := qualities[MIMEProblemJSON]
+	if !ok || problemQ <= 0 {
+		return false
+	}
+	// application/json 没有单独列出时使用最具体的通配符
+	jsonQ := 0.0
+	for _, mediaType := range []string{gin.MIMEJSON, "application/*", "*/*"} {
+		if q, ok := qualities[mediaType]; ok {
+			jsonQ = q
+			break
+		}
+	}
+	return problemQ > jsonQ
+}
+
+// NewProblem 根据应用错误生成 problem details
+func NewProblem(c *gin.Context, appErr *Error) Problem {
+	status := appErr.Status()
+	problem := Problem{}
+	// 扩展成员先写入，避免覆盖标准成员
+	switch data := appErr.Data.(type) {
+	case gin.H:
+		for k, v := range data {
+			problem[k] = v
+		}
+	case map[string]interface{}:
+		for k, v := range data {
+			problem[k] = v
+		}
+	}
+
+	problem["type"] = ProblemTypeBase + strconv.Itoa(appErr.Code)
+	problem["title"] = http.StatusText(status)
+	problem["status"] = status
+	problem["detail"] = appErr.Message
+	problem["instance"] = c.Request.URL.Path
+	problem["code"] = appErr.Code
+	problem["trace_id"] = c.GetString(string(constant.CtxTraceID))
+	return problem
+}
+
+// renderProblem 以 application/problem+json 输出错误
+func renderProblem(c *gin.Context, appErr *Error) {
+	problem := NewProblem(c, appErr)
+	// 设置返回结果，在 IOLog 中间件中记录日志
+	c.Set(string(constant.CtxAPIOutput), problem)
+	c.Header("Content-Type", MIMEProblemJSON)
+	c.JSON(appErr.Status(), problem)
+}
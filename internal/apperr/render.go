@@ -0,0 +1,37 @@
+package apperr
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/jessewkun/gocommon/response"
+)
+
+// Render 把 err 输出为 response 信封，HTTP 状态码由错误类型决定，信封中带有 trace_id
+//
+// 客户端通过 Accept: application/problem+json 协商时输出 RFC 7807 problem details。
+// 内部错误及超时只返回通用提示，原始错误记录到日志中
+func Render(c *gin.Context, err error) {
+	appErr := From(err)
+	if appErr.Kind == KindInternal || appErr.Kind == KindTimeout {
+		logger.ErrorWithField(c, "APP_ERROR", err.Error(), map[string]interface{}{
+			"code": appErr.Code,
+			"path": c.Request.URL.Path,
+		})
+	}
+	if wantsProblem(c) {
+		renderProblem(c, appErr)
+		return
+	}
+
+	var data interface{} = struct{}{}
+	if appErr.Data != nil {
+		data = appErr.Data
+	}
+	c.JSON(appErr.Status(), response.NewAPIResult(c, appErr.Code, appErr.Message, data))
+}
+
+// Abort 输出错误并终止后续的中间件和处理函数
+func Abort(c *gin.Context, err error) {
+	Render(c, err)
+	c.Abort()
+}
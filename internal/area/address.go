@@ -0,0 +1,348 @@
+// Package area 提供省市区相关能力，例如地址解析、拼音搜索及运行时更新省市区数据
+package area
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"godemo/internal/constants"
+)
+
+// Level 行政区划级别
+type Level int
+
+const (
+	LevelProvince Level = iota + 1 // 省
+	LevelCity                      // 市
+	LevelDistrict                  // 区县
+)
+
+// 各级别在置信度中的权重
+var levelWeights = map[Level]float64{
+	LevelProvince: 0.3,
+	LevelCity:     0.3,
+	LevelDistrict: 0.4,
+}
+
+// 不同匹配方式的置信度系数
+const (
+	qualityFullName = 1.0 // 匹配到完整名称，如"杭州市"
+	qualityAlias    = 0.9 // 匹配到省略后缀的简称，如"杭州"
+	qualityInferred = 0.8 // 未出现在地址中，由下级区划反推
+)
+
+// 占位的城市名称，实际地址中不会出现，由区县反推时不降低置信度
+var placeholderCities = map[string]bool{
+	"市辖区":         true,
+	"县":           true,
+	"省直辖县级行政区划":   true,
+	"自治区直辖县级行政区划": true,
+}
+
+// 按长度降序排列的行政区划后缀，生成简称时依次尝试去除
+var nameSuffixes = []string{"特别行政区", "自治区", "自治州", "自治县", "自治旗", "新区", "林区", "特区", "地区", "省", "市", "区", "县", "旗", "盟"}
+
+// 少数民族名称，用于把"延边朝鲜族自治州"简化为"延边"
+var ethnicGroups = []string{
+	"蒙古", "回", "藏", "维吾尔", "苗", "彝", "壮", "布依", "朝鲜", "满", "侗", "瑶", "白", "土家", "哈尼",
+	"哈萨克", "傣", "黎", "傈僳", "佤", "畲", "拉祜", "水", "东乡", "纳西", "景颇", "柯尔克孜", "土", "达斡尔",
+	"仫佬", "羌", "撒拉", "毛南", "仡佬", "锡伯", "普米", "塔吉克", "怒", "鄂温克", "保安", "裕固", "京", "独龙",
+	"鄂伦春", "赫哲", "门巴", "珞巴", "基诺", "俄罗斯", "乌孜别克", "塔塔尔", "德昂", "阿昌", "布朗",
+}
+
+// Region 解析出的省市区
+type Region struct {
+	ProvinceCode string
+	ProvinceName string
+	CityCode     string
+	CityName     string
+	DistrictCode string
+	DistrictName string
+}
+
+// ParseResult 地址解析结果
+type ParseResult struct {
+	Region
+	Detail     string   // 去掉省市区之后的详细地址
+	Confidence float64  // 置信度，取值 0~1
+	Candidates []Region // 区划名称有歧义时的所有候选项，此时 Region 只保留候选项共同的部分
+}
+
+// node 地名索引中的一个条目
+type node struct {
+	level  Level
+	region Region
+	full   bool // 是否为完整名称
+}
+
+// AddressParser 地址解析器
+//
+// 按"省 -> 市 -> 区县"的顺序在地址开头做最长匹配，支持省略后缀、跳级书写以及直辖市"市辖区"的情况
+type AddressParser struct {
+	names  map[string][]node
+	maxLen int
+	// 只有一个同名区县的城市，如东莞市、儋州市，key 为城市编码
+	singleDistricts map[string]Region
+}
+
+// NewAddressParser 根据三级省市区结构创建地址解析器
+func NewAddressParser(areas map[string]constants.Area) *AddressParser {
+	p := &AddressParser{
+		names:           make(map[string][]node),
+		singleDistricts: make(map[string]Region),
+	}
+
+	provinceCodes := make([]string, 0, len(areas))
+	for code := range areas {
+		provinceCodes = append(provinceCodes, code)
+	}
+	sort.Strings(provinceCodes)
+
+	for _, provinceCode := range provinceCodes {
+		province := areas[provinceCode]
+		provinceRegion := Region{ProvinceCode: province.Code, ProvinceName: province.Name}
+		p.add(province.Name, LevelProvince, provinceRegion)
+
+		for _, city := range province.Children {
+			cityRegion := provinceRegion
+			cityRegion.CityCode, cityRegion.CityName = city.Code, city.Name
+			if placeholderCities[city.Name] {
+				// 直辖市的第一个"市辖区"允许用直辖市名称指代，如"北京市北京市朝阳区"
+				if strings.HasSuffix(city.Code, "01") && strings.HasSuffix(province.Name, "市") {
+					p.add(province.Name, LevelCity, cityRegion)
+				}
+			} else {
+				p.add(city.Name, LevelCity, cityRegion)
+			}
+
+			for _, district := range city.Children {
+				districtRegion := cityRegion
+				districtRegion.DistrictCode, districtRegion.DistrictName = district.Code, district.Name
+				p.add(district.Name, LevelDistrict, districtRegion)
+				if len(city.Children) == 1 && district.Name == city.Name {
+					p.singleDistricts[city.Code] = districtRegion
+				}
+			}
+		}
+	}
+	return p
+}
+
+// add 为地名及其简称建立索引
+func (p *AddressParser) add(name string, level Level, region Region) {
+	p.addName(name, node{level: level, region: region, full: true})
+	for _, alias := range shortNames(name) {
+		p.addName(alias, node{level: level, region: region})
+	}
+}
+
+func (p *AddressParser) addName(name string, n node) {
+	p.names[name] = append(p.names[name], n)
+	if l := len([]rune(name)); l > p.maxLen {
+		p.maxLen = l
+	}
+}
+
+// shortNames 去掉行政区划后缀和民族名称，生成至少两个字的简称
+func shortNames(name string) []string {
+	short := name
+	for _, suffix := range nameSuffixes {
+		if strings.HasSuffix(short, suffix) {
+			short = strings.TrimSuffix(short, suffix)
+			if strings.HasPrefix(suffix, "自治") {
+				short = trimEthnicGroups(short)
+			}
+			break
+		}
+	}
+
+	if short == name || len([]rune(short)) < 2 {
+		return nil
+	}
+	return []string{short}
+}
+
+// trimEthnicGroups 依次去掉末尾的民族名称，如"红河哈尼族彝族" -> "红河"、"新疆维吾尔" -> "新疆"
+//
+// 去掉后不足两个字时保留原样，避免"内蒙古"被简化为"内"
+func trimEthnicGroups(name string) string {
+	for {
+		trimmed := false
+		for _, group := range ethnicGroups {
+			for _, suffix := range []string{group + "族", group} {
+				rest := strings.TrimSuffix(name, suffix)
+				if rest != name && len([]rune(rest)) >= 2 {
+					name, trimmed = rest, true
+					break
+				}
+			}
+			if trimmed {
+				break
+			}
+		}
+		if !trimmed {
+			return name
+		}
+	}
+}
+
+// Parse 解析自由文本地址
+func (p *AddressParser) Parse(address string) ParseResult {
+	text := []rune(strings.TrimPrefix(strings.TrimSpace(address), "中国"))
+
+	var (
+		result    ParseResult
+		current   Region
+		level     Level
+		pos       int
+		qualities = make(map[Level]float64)
+	)
+
+	for level < LevelDistrict {
+		pos = skipSeparators(text, pos)
+		n, nodes := p.match(text[pos:], level, current)
+		if n == 0 {
+			break
+		}
+		pos += n
+
+		if len(nodes) > 1 {
+			// 同名区划无法区分时不做猜测，返回全部候选项
+			for _, nd := range nodes {
+				result.Candidates = append(result.Candidates, nd.region)
+			}
+			current = commonRegion(result.Candidates)
+			level = regionLevel(current)
+			break
+		}
+
+		matched := nodes[0]
+		if matched.full {
+			qualities[matched.level] = qualityFullName
+		} else {
+			qualities[matched.level] = qualityAlias
+		}
+		current, level = matched.region, matched.level
+	}
+
+	// 城市下只有一个同名区县时直接补全
+	if level == LevelCity && len(result.Candidates) == 0 {
+		if region, ok := p.singleDistricts[current.CityCode]; ok {
+			current = region
+			qualities[LevelDistrict] = qualityInferred
+		}
+	}
+
+	result.Region = current
+	result.Detail = strings.TrimSpace(string(text[skipSeparators(text, pos):]))
+	result.Confidence = confidence(current, qualities, len(result.Candidates) > 0)
+	return result
+}
+
+// match 在 text 开头查找最长的地名，只考虑级别低于 after 且属于 parent 的区划
+//
+// 名称长度相同时优先选择级别更高的区划，例如"吉林"优先匹配吉林省
+func (p *AddressParser) match(text []rune, after Level, parent Region) (int, []node) {
+	for l := min(p.maxLen, len(text)); l > 0; l-- {
+		var best []node
+		for _, nd := range p.names[string(text[:l])] {
+			if nd.level <= after || !belongsTo(nd.region, parent) {
+				continue
+			}
+			switch {
+			case len(best) == 0 || nd.level < best[0].level:
+				best = []node{nd}
+			case nd.level == best[0].level:
+				best = append(best, nd)
+			}
+		}
+		if len(best) > 0 {
+			return l, best
+		}
+	}
+	return 0, nil
+}
+
+// belongsTo 判断 region 是否属于 parent 已确定的上级区划
+func belongsTo(region, parent Region) bool {
+	if parent.ProvinceCode != "" && region.ProvinceCode != parent.ProvinceCode {
+		return false
+	}
+	if parent.CityCode != "" && region.CityCode != parent.CityCode {
+		return false
+	}
+	return true
+}
+
+// commonRegion 返回所有候选项共同的上级区划
+func commonRegion(regions []Region) Region {
+	common := regions[0]
+	for _, r := range regions[1:] {
+		if r.ProvinceCode != common.ProvinceCode {
+			return Region{}
+		}
+		if r.CityCode != common.CityCode {
+			common.CityCode, common.CityName = "", ""
+		}
+		if r.DistrictCode != common.DistrictCode {
+			common.DistrictCode, common.DistrictName = "", ""
+		}
+	}
+	return common
+}
+
+// regionLevel 返回 region 已确定的最低级别
+func regionLevel(region Region) Level {
+	switch {
+	case region.DistrictCode != "":
+		return LevelDistrict
+	case region.CityCode != "":
+		return LevelCity
+	case region.ProvinceCode != "":
+		return LevelProvince
+	}
+	return 0
+}
+
+// confidence 根据各级别的匹配方式计算置信度，存在歧义时减半
+func confidence(region Region, qualities map[Level]float64, ambiguous bool) float64 {
+	codes := map[Level]string{
+		LevelProvince: region.ProvinceCode,
+		LevelCity:     region.CityCode,
+		LevelDistrict: region.DistrictCode,
+	}
+
+	var score float64
+	for level, weight := range levelWeights {
+		if codes[level] == "" {
+			continue
+		}
+		quality, ok := qualities[level]
+		if !ok {
+			quality = qualityInferred
+			if level == LevelCity && placeholderCities[region.CityName] {
+				quality = qualityFullName
+			}
+		}
+		score += weight * quality
+	}
+	if ambiguous {
+		score /= 2
+	}
+	return math.Round(score*100) / 100
+}
+
+// skipSeparators 跳过地址各部分之间的空白和分隔符
+func skipSeparators(text []rune, pos int) int {
+	for pos < len(text) && (unicode.IsSpace(text[pos]) || strings.ContainsRune(",，、/-", text[pos])) {
+		pos++
+	}
+	return pos
+}
+
+// ParseAddress 使用当前生效的数据集解析地址
+func ParseAddress(address string) ParseResult {
+	return Current().Parse(address)
+}
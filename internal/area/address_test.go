@@ -0,0 +1,162 @@
+package area
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		address    string
+		province   string
+		city       string
+		district   string
+		detail     string
+		confidence float64
+		candidates int
+	}{
+		{
+			name:       "省略省市后缀",
+			address:    "浙江杭州西湖区文三路 100 号",
+			province:   "33",
+			city:       "3301",
+			district:   "330106",
+			detail:     "文三路 100 号",
+			confidence: 0.94,
+		},
+		{
+			name:       "完整名称",
+			address:    "浙江省杭州市西湖区文三路100号",
+			province:   "33",
+			city:       "3301",
+			district:   "330106",
+			detail:     "文三路100号",
+			confidence: 1,
+		},
+		{
+			name:       "直辖市市辖区",
+			address:    "北京市朝阳区建国路88号",
+			province:   "11",
+			city:       "1101",
+			district:   "110105",
+			detail:     "建国路88号",
+			confidence: 1,
+		},
+		{
+			name:       "直辖市重复书写",
+			address:    "上海市上海市浦东新区世纪大道1号",
+			province:   "31",
+			city:       "3101",
+			district:   "310115",
+			detail:     "世纪大道1号",
+			confidence: 1,
+		},
+		{
+			name:       "省略省份",
+			address:    "杭州市西湖区文三路",
+			province:   "33",
+			city:       "3301",
+			district:   "330106",
+			detail:     "文三路",
+			confidence: 0.94,
+		},
+		{
+			name:       "省略城市",
+			address:    "湖北仙桃市干河街道",
+			province:   "42",
+			city:       "4290",
+			district:   "429004",
+			detail:     "干河街道",
+			confidence: 0.97,
+		},
+		{
+			name:       "自治州简称",
+			address:    "吉林延边延吉市",
+			province:   "22",
+			city:       "2224",
+			district:   "222401",
+			confidence: 0.94,
+		},
+		{
+			name:       "名称相同时优先匹配上级",
+			address:    "吉林吉林市船营区",
+			province:   "22",
+			city:       "2202",
+			district:   "220204",
+			confidence: 0.97,
+		},
+		{
+			name:       "不设区的地级市",
+			address:    "广东东莞市南城街道",
+			province:   "44",
+			city:       "4419",
+			district:   "441900",
+			detail:     "南城街道",
+			confidence: 0.89,
+		},
+		{
+			name:       "歧义区县",
+			address:    "西湖区文三路",
+			detail:     "文三路",
+			candidates: 2,
+		},
+		{
+			name:       "上级区划消除歧义",
+			address:    "江西西湖区",
+			province:   "36",
+			city:       "3601",
+			district:   "360103",
+			confidence: 0.91,
+		},
+		{
+			name:       "空格分隔",
+			address:    "  中国 广西 南宁市 青秀区 民族大道 ",
+			province:   "45",
+			city:       "4501",
+			district:   "450103",
+			detail:     "民族大道",
+			confidence: 0.97,
+		},
+		{
+			name:    "无法识别",
+			address: "文三路100号",
+			detail:  "文三路100号",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseAddress(tt.address)
+			assert.Equal(t, tt.province, result.ProvinceCode)
+			assert.Equal(t, tt.city, result.CityCode)
+			assert.Equal(t, tt.district, result.DistrictCode)
+			assert.Equal(t, tt.detail, result.Detail)
+			assert.Equal(t, tt.confidence, result.Confidence)
+			assert.Len(t, result.Candidates, tt.candidates)
+		})
+	}
+}
+
+func TestShortNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{name: "浙江省", expected: []string{"浙江"}},
+		{name: "广西壮族自治区", expected: []string{"广西"}},
+		{name: "新疆维吾尔自治区", expected: []string{"新疆"}},
+		{name: "内蒙古自治区", expected: []string{"内蒙古"}},
+		{name: "红河哈尼族彝族自治州", expected: []string{"红河"}},
+		{name: "浦东新区", expected: []string{"浦东"}},
+		{name: "城区"},
+		{name: "随县"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, shortNames(tt.name))
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package area
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"godemo/internal/constants"
+)
+
+// Dataset 一个版本的省市区数据，创建后只读，可以在多个协程间共享
+type Dataset struct {
+	Version    string
+	Areas      constants.Areas
+	Successors map[string]string // 已撤销的区划编码 -> 继任编码
+	Pinyins    map[string]string // 区划编码 -> 拼音，音节之间以空格分隔
+
+	parser   *AddressParser
+	searcher *Searcher
+}
+
+// DatasetItem 数据文件中的一个区划
+type DatasetItem struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// DatasetFile 外部省市区数据文件的格式
+type DatasetFile struct {
+	Version    string            `json:"version"`    // 数据版本，如"2024"
+	Provinces  []DatasetItem     `json:"provinces"`  // 省份，2位编码
+	Cities     []DatasetItem     `json:"cities"`     // 城市，4位编码
+	Districts  []DatasetItem     `json:"districts"`  // 区县，6位编码
+	Successors map[string]string `json:"successors"` // 已撤销的区划编码 -> 继任编码
+	Pinyin     map[string]string `json:"pinyin"`     // 可选，未提供时名称未变化的区划沿用内置拼音
+}
+
+// NewDataset 创建数据集，同时构建地址解析器和拼音搜索索引
+func NewDataset(version string, areas constants.Areas, successors, pinyins map[string]string) *Dataset {
+	return &Dataset{
+		Version:    version,
+		Areas:      areas,
+		Successors: successors,
+		Pinyins:    pinyins,
+		parser:     NewAddressParser(areas),
+		searcher:   NewSearcher(areas, pinyins),
+	}
+}
+
+// EmbeddedDataset 使用 constants 中内置的数据创建数据集
+func EmbeddedDataset() *Dataset {
+	return NewDataset(constants.AreaVersion, constants.AreaMap, constants.AreaSuccessors, constants.PinyinMap)
+}
+
+// ParseDataset 解析并校验 DatasetFile 格式的数据
+func ParseDataset(data []byte) (*Dataset, error) {
+	var file DatasetFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse dataset: %w", err)
+	}
+	if file.Version == "" {
+		return nil, fmt.Errorf("dataset version is empty")
+	}
+	if len(file.Districts) == 0 {
+		return nil, fmt.Errorf("dataset %s has no districts", file.Version)
+	}
+
+	names := make(map[string]string)
+	add := func(items []DatasetItem, codeLen int) error {
+		for _, item := range items {
+			if len(item.Code) != codeLen || item.Name == "" {
+				return fmt.Errorf("invalid area %q(%q)", item.Code, item.Name)
+			}
+			if _, ok := names[item.Code]; ok {
+				return fmt.Errorf("duplicate area %s", item.Code)
+			}
+			if codeLen > 2 && names[item.Code[:codeLen-2]] == "" {
+				return fmt.Errorf("area %s has no parent", item.Code)
+			}
+			names[item.Code] = item.Name
+		}
+		return nil
+	}
+	for i, items := range [][]DatasetItem{file.Provinces, file.Cities, file.Districts} {
+		if err := add(items, (i+1)*2); err != nil {
+			return nil, fmt.Errorf("dataset %s: %w", file.Version, err)
+		}
+	}
+
+	for code, successor := range file.Successors {
+		if _, ok := names[code]; ok {
+			return nil, fmt.Errorf("dataset %s: retired code %s still exists", file.Version, code)
+		}
+		if _, ok := names[successor]; !ok {
+			return nil, fmt.Errorf("dataset %s: successor %s of %s not found", file.Version, successor, code)
+		}
+	}
+
+	provinceNames := make(map[string]string, len(file.Provinces))
+	for _, item := range file.Provinces {
+		provinceNames[item.Code] = item.Name
+	}
+	cityNames := make(map[string]string, len(file.Cities))
+	for _, item := range file.Cities {
+		cityNames[item.Code] = item.Name
+	}
+	items := make([]constants.AreaItem, 0, len(file.Districts))
+	for _, item := range file.Districts {
+		items = append(items, constants.AreaItem{
+			Code:         item.Code,
+			Name:         item.Name,
+			CityCode:     item.Code[:4],
+			ProvinceCode: item.Code[:2],
+		})
+	}
+
+	// 名称未变化的区划沿用内置拼音，避免数据文件必须携带完整的拼音表
+	pinyins := make(map[string]string, len(names))
+	for code, name := range names {
+		if py, ok := file.Pinyin[code]; ok {
+			pinyins[code] = py
+		} else if embeddedName(code) == name {
+			pinyins[code] = constants.PinyinMap[code]
+		}
+	}
+
+	successors := file.Successors
+	if successors == nil {
+		successors = make(map[string]string)
+	}
+	return NewDataset(file.Version, constants.BuildAreas(items, provinceNames, cityNames), successors, pinyins), nil
+}
+
+// embeddedName 返回内置数据中编码对应的名称
+func embeddedName(code string) string {
+	switch len(code) {
+	case 2:
+		return constants.ProvinceMap[code]
+	case 4:
+		return constants.CityMap[code]
+	case 6:
+		return constants.DistrictMap[code]
+	}
+	return ""
+}
+
+// Parse 解析自由文本地址
+func (d *Dataset) Parse(address string) ParseResult {
+	return d.parser.Parse(address)
+}
+
+// Search 按汉字、全拼、首字母搜索省市区
+func (d *Dataset) Search(query string, limit int) []SearchResult {
+	return d.searcher.Search(query, limit)
+}
+
+// Successor 返回已撤销编码的最终继任编码，多次调整时沿着继任关系一直查找到仍然有效的编码
+func (d *Dataset) Successor(code string) (string, bool) {
+	successor, ok := d.Successors[code]
+	if !ok {
+		return "", false
+	}
+	// 限制查找次数，避免数据中存在环时死循环
+	for i := 0; i < len(d.Successors); i++ {
+		next, ok := d.Successors[successor]
+		if !ok {
+			break
+		}
+		successor = next
+	}
+	return successor, true
+}
@@ -0,0 +1,188 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"godemo/internal/constants"
+
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 浙江省杭州市的一份精简数据，2021年下城区并入拱墅区
+const testDataset = `{
+	"version": "2022",
+	"provinces": [{"code": "33", "name": "浙江省"}],
+	"cities": [{"code": "3301", "name": "杭州市"}],
+	"districts": [
+		{"code": "330105", "name": "拱墅区"},
+		{"code": "330106", "name": "西湖区"},
+		{"code": "330199", "name": "测试区"}
+	],
+	"successors": {"330103": "330105"},
+	"pinyin": {"330199": "ce shi qu"}
+}`
+
+func TestParseDataset(t *testing.T) {
+	dataset, err := ParseDataset([]byte(testDataset))
+	require.NoError(t, err)
+	assert.Equal(t, "2022", dataset.Version)
+
+	district, ok := dataset.Areas.District("330199")
+	assert.True(t, ok)
+	assert.Equal(t, "测试区", district.Name)
+
+	// 名称未变化的区划沿用内置拼音
+	assert.Equal(t, constants.PinyinMap["330106"], dataset.Pinyins["330106"])
+	assert.Equal(t, "ce shi qu", dataset.Pinyins["330199"])
+
+	results := dataset.Search("ceshi", 0)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "330199", results[0].Code)
+
+	result := dataset.Parse("浙江杭州测试区文一路")
+	assert.Equal(t, "330199", result.DistrictCode)
+}
+
+func TestParseDatasetInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		errMsg  string
+	}{
+		{name: "缺少版本", replace: [2]string{`"version": "2022"`, `"version": ""`}, errMsg: "version is empty"},
+		{name: "编码长度错误", replace: [2]string{`"code": "330199"`, `"code": "33019"`}, errMsg: "invalid area"},
+		{name: "缺少上级区划", replace: [2]string{`"code": "330199"`, `"code": "330299"`}, errMsg: "has no parent"},
+		{name: "重复编码", replace: [2]string{`"code": "330199"`, `"code": "330106"`}, errMsg: "duplicate area"},
+		{name: "已撤销编码仍存在", replace: [2]string{`"330103": "330105"`, `"330106": "330105"`}, errMsg: "still exists"},
+		{name: "继任编码不存在", replace: [2]string{`"330103": "330105"`, `"330103": "330104"`}, errMsg: "not found"},
+		{name: "格式错误", replace: [2]string{`"districts": [`, `"districts": {`}, errMsg: "parse dataset"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDataset([]byte(strings.Replace(testDataset, tt.replace[0], tt.replace[1], 1)))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestDatasetSuccessor(t *testing.T) {
+	dataset := NewDataset("test", constants.AreaMap, map[string]string{
+		"330103": "330104",
+		"330104": "330102",
+		"990101": "990102",
+		"990102": "990101",
+	}, nil)
+
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+		ok       bool
+	}{
+		{name: "多次调整", code: "330103", expected: "330102", ok: true},
+		{name: "一次调整", code: "330104", expected: "330102", ok: true},
+		{name: "未撤销", code: "330106"},
+		{name: "继任关系成环", code: "990101", expected: "990102", ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			successor, ok := dataset.Successor(tt.code)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, successor)
+		})
+	}
+}
+
+func TestEmbeddedDataset(t *testing.T) {
+	dataset := EmbeddedDataset()
+	assert.Equal(t, constants.AreaVersion, dataset.Version)
+
+	successor, ok := dataset.Successor("330103")
+	assert.True(t, ok)
+	assert.Equal(t, "330105", successor)
+}
+
+func TestProviderLoad(t *testing.T) {
+	provider := NewProvider(EmbeddedDataset())
+	embedded := provider.Dataset()
+
+	path := filepath.Join(t.TempDir(), "areas.json")
+	require.NoError(t, os.WriteFile(path, []byte(testDataset), 0o644))
+
+	swapped, err := provider.LoadFrom(context.Background(), FileSource(path))
+	require.NoError(t, err)
+	assert.True(t, swapped)
+	assert.Equal(t, "2022", provider.Version())
+
+	// 已经持有的旧数据集不受替换影响
+	_, ok := embedded.Areas.Province("11")
+	assert.True(t, ok)
+	_, ok = provider.Dataset().Areas.Province("11")
+	assert.False(t, ok)
+
+	// 版本相同时不替换
+	swapped, err = provider.Load(strings.NewReader(testDataset))
+	require.NoError(t, err)
+	assert.False(t, swapped)
+
+	// 数据无效时保留当前数据
+	current := provider.Dataset()
+	_, err = provider.Load(strings.NewReader(`{"version": "2023"}`))
+	require.Error(t, err)
+	assert.Same(t, current, provider.Dataset())
+
+	_, err = provider.LoadFrom(context.Background(), FileSource(filepath.Join(t.TempDir(), "missing.json")))
+	assert.Error(t, err)
+}
+
+func TestReloaderStop(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	// Start 没有执行时直接返回，不等待后台检查结束
+	r := NewReloader(NewProvider(EmbeddedDataset()), FileSource(filepath.Join(t.TempDir(), "missing.json")), time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, r.Stop(ctx))
+	require.NoError(t, r.Stop(ctx))
+
+	// 重复停止不 panic
+	r = NewReloader(NewProvider(EmbeddedDataset()), FileSource(filepath.Join(t.TempDir(), "missing.json")), time.Hour)
+	require.NoError(t, r.Start(context.Background()))
+	require.NoError(t, r.Stop(ctx))
+	require.NoError(t, r.Stop(ctx))
+}
+
+func TestReloaderOnSwap(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	path := filepath.Join(t.TempDir(), "areas.json")
+	require.NoError(t, os.WriteFile(path, []byte(testDataset), 0o644))
+
+	r := NewReloader(NewProvider(EmbeddedDataset()), FileSource(path), 0)
+	var swapped int
+	r.OnSwap(func(ctx context.Context) error {
+		swapped++
+		return nil
+	})
+	r.OnSwap(func(ctx context.Context) error { return errors.New("purge failed") })
+
+	// 替换为新版本后执行回调，回调失败不影响其他回调
+	require.NoError(t, r.Start(context.Background()))
+	assert.Equal(t, 1, swapped)
+	assert.Equal(t, "2022", r.provider.Version())
+
+	// 版本相同时不替换，也不执行回调
+	r.reload(context.Background())
+	assert.Equal(t, 1, swapped)
+}
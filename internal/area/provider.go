@@ -0,0 +1,214 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/jessewkun/gocommon/oss"
+	"github.com/jessewkun/gocommon/safego"
+)
+
+// Provider 持有当前生效的省市区数据集，支持运行时原子替换
+//
+// 调用方每次通过 Dataset 获取数据集快照，替换过程中正在处理的请求继续使用旧数据，不会读到一半新一半旧的数据
+type Provider struct {
+	current atomic.Pointer[Dataset]
+}
+
+// NewProvider 创建以 dataset 为初始数据的 Provider
+func NewProvider(dataset *Dataset) *Provider {
+	p := &Provider{}
+	p.current.Store(dataset)
+	return p
+}
+
+// Dataset 返回当前生效的数据集
+func (p *Provider) Dataset() *Dataset {
+	return p.current.Load()
+}
+
+// Version 返回当前生效的数据版本
+func (p *Provider) Version() string {
+	return p.Dataset().Version
+}
+
+// Swap 替换为 dataset，返回被替换的数据集
+func (p *Provider) Swap(dataset *Dataset) *Dataset {
+	return p.current.Swap(dataset)
+}
+
+// Load 从 r 读取 DatasetFile 格式的数据，校验通过且版本与当前不同时替换，返回是否发生了替换
+func (p *Provider) Load(r io.Reader) (bool, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return false, fmt.Errorf("read dataset: %w", err)
+	}
+	dataset, err := ParseDataset(data)
+	if err != nil {
+		return false, err
+	}
+	if dataset.Version == p.Version() {
+		return false, nil
+	}
+	p.Swap(dataset)
+	return true, nil
+}
+
+// LoadFrom 从 source 加载数据，参见 Load
+func (p *Provider) LoadFrom(ctx context.Context, source Source) (bool, error) {
+	rc, err := source.Open(ctx)
+	if err != nil {
+		return false, fmt.Errorf("open %s: %w", source, err)
+	}
+	defer rc.Close()
+
+	swapped, err := p.Load(rc)
+	if err != nil {
+		return false, fmt.Errorf("load %s: %w", source, err)
+	}
+	return swapped, nil
+}
+
+var defaultProvider = sync.OnceValue(func() *Provider {
+	return NewProvider(EmbeddedDataset())
+})
+
+// Default 返回全局 Provider，初始数据为内置数据集
+func Default() *Provider {
+	return defaultProvider()
+}
+
+// Current 返回全局 Provider 当前生效的数据集
+func Current() *Dataset {
+	return Default().Dataset()
+}
+
+// Source 省市区数据来源
+type Source interface {
+	Open(ctx context.Context) (io.ReadCloser, error)
+	String() string
+}
+
+// FileSource 本地文件数据源
+type FileSource string
+
+// Open 打开本地文件
+func (s FileSource) Open(ctx context.Context) (io.ReadCloser, error) {
+	return os.Open(string(s))
+}
+
+func (s FileSource) String() string {
+	return "file:" + string(s)
+}
+
+// OssSource OSS 数据源
+type OssSource struct {
+	Client *oss.Oss
+	Bucket string
+	Key    string
+}
+
+// Open 读取 OSS 对象
+func (s OssSource) Open(ctx context.Context) (io.ReadCloser, error) {
+	return s.Client.GetObjectToReader(s.Bucket, s.Key)
+}
+
+func (s OssSource) String() string {
+	return "oss://" + s.Bucket + "/" + s.Key
+}
+
+// Reloader 启动时及按固定间隔从数据源加载新版本的省市区数据，实现了 app.Server 接口
+//
+// 加载失败时继续使用当前数据，只记录日志，不影响服务启动
+type Reloader struct {
+	provider *Provider
+	source   Source
+	interval time.Duration
+	onSwap   []func(ctx context.Context) error
+	started  atomic.Bool
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewReloader 创建 Reloader，interval 为 0 时只在启动时加载一次
+func NewReloader(provider *Provider, source Source, interval time.Duration) *Reloader {
+	return &Reloader{
+		provider: provider,
+		source:   source,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// OnSwap 注册替换为新版本数据后执行的回调，如清除省市区接口的响应缓存，需要在 Start 之前调用
+//
+// 回调返回错误时只记录日志，不影响新数据生效
+func (r *Reloader) OnSwap(fn func(ctx context.Context) error) {
+	r.onSwap = append(r.onSwap, fn)
+}
+
+// Start 同步加载一次数据，并在后台定期检查新版本
+func (r *Reloader) Start(ctx context.Context) error {
+	r.started.Store(true)
+	r.reload(ctx)
+	if r.interval <= 0 {
+		close(r.done)
+		return nil
+	}
+
+	go safego.SafeGo(ctx, func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.reload(ctx)
+			case <-r.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// Stop 停止后台检查，可以重复调用，Start 没有执行时直接返回
+func (r *Reloader) Stop(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	if !r.started.Load() {
+		return nil
+	}
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Reloader) reload(ctx context.Context) {
+	old := r.provider.Version()
+	swapped, err := r.provider.LoadFrom(ctx, r.source)
+	if err != nil {
+		logger.Error(ctx, "AREA_DATASET", fmt.Errorf("reload area dataset failed, keep version %s: %w", old, err))
+		return
+	}
+	if swapped {
+		logger.Info(ctx, "AREA_DATASET", "area dataset updated from %s to %s, source: %s", old, r.provider.Version(), r.source)
+		for _, fn := range r.onSwap {
+			if err := fn(ctx); err != nil {
+				logger.Error(ctx, "AREA_DATASET", fmt.Errorf("area dataset swap hook failed: %w", err))
+			}
+		}
+	}
+}
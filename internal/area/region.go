@@ -0,0 +1,35 @@
+package area
+
+// LookupRegion 使用当前生效的数据集根据2、4、6位编码查找省市区
+func LookupRegion(code string) (Region, bool) {
+	return Current().LookupRegion(code)
+}
+
+// LookupRegion 根据2、4、6位编码查找省市区
+//
+// 编码中未收录的部分会被忽略，只返回能识别出的上级区划，例如身份证号中已撤销的区县编码仍能识别出所属省市。
+// 连省份都无法识别时返回 false
+func (d *Dataset) LookupRegion(code string) (Region, bool) {
+	var region Region
+	if len(code) < 2 {
+		return region, false
+	}
+
+	province, ok := d.Areas.Province(code[:2])
+	if !ok {
+		return region, false
+	}
+	region.ProvinceCode, region.ProvinceName = province.Code, province.Name
+
+	if len(code) >= 4 {
+		if city, ok := d.Areas.City(code[:4]); ok {
+			region.CityCode, region.CityName = city.Code, city.Name
+		}
+	}
+	if len(code) >= 6 && region.CityCode != "" {
+		if district, ok := d.Areas.District(code[:6]); ok {
+			region.DistrictCode, region.DistrictName = district.Code, district.Name
+		}
+	}
+	return region, true
+}
@@ -0,0 +1,45 @@
+package area
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected Region
+		ok       bool
+	}{
+		{
+			name:     "区县",
+			code:     "330106",
+			expected: Region{ProvinceCode: "33", ProvinceName: "浙江省", CityCode: "3301", CityName: "杭州市", DistrictCode: "330106", DistrictName: "西湖区"},
+			ok:       true,
+		},
+		{
+			name:     "已撤销的区县",
+			code:     "330103",
+			expected: Region{ProvinceCode: "33", ProvinceName: "浙江省", CityCode: "3301", CityName: "杭州市"},
+			ok:       true,
+		},
+		{
+			name:     "省份",
+			code:     "33",
+			expected: Region{ProvinceCode: "33", ProvinceName: "浙江省"},
+			ok:       true,
+		},
+		{name: "不存在的省份", code: "990101"},
+		{name: "编码过短", code: "3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, ok := LookupRegion(tt.code)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, region)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package area
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"godemo/internal/constants"
+)
+
+// MatchType 搜索命中方式，取值越大匹配度越高
+type MatchType int
+
+const (
+	MatchContains       MatchType = iota + 1 // 名称包含关键字，如"湖"命中"西湖区"
+	MatchMixed                               // 汉字、全拼、首字母混合输入，如"hang州"、"hzhou"
+	MatchInitialsPrefix                      // 首字母前缀，如"h"
+	MatchPinyinPrefix                        // 全拼前缀，如"hangz"
+	MatchPrefix                              // 名称前缀，如"杭"
+	MatchInitials                            // 首字母，如"hz"
+	MatchPinyin                              // 全拼，如"hangzhou"
+	MatchExact                               // 完整名称或简称，如"杭州市"、"杭州"
+)
+
+// SearchResult 拼音搜索结果
+type SearchResult struct {
+	Region
+	Level  Level
+	Code   string
+	Name   string
+	Pinyin string
+	Match  MatchType
+}
+
+// entry 搜索索引中的一个区划
+type entry struct {
+	result    SearchResult
+	runes     []rune
+	syllables []string // 归一化后的音节，ü 统一为 u
+	shortLen  int      // 简称的字数，简称总是完整名称的前缀
+}
+
+// Searcher 省市区拼音搜索，支持汉字、全拼、首字母及三者混合输入
+type Searcher struct {
+	entries []entry
+}
+
+// NewSearcher 根据三级省市区结构及拼音表创建搜索器，pinyins 的 key 为区划编码
+func NewSearcher(areas map[string]constants.Area, pinyins map[string]string) *Searcher {
+	s := &Searcher{}
+	for _, province := range areas {
+		provinceRegion := Region{ProvinceCode: province.Code, ProvinceName: province.Name}
+		s.add(LevelProvince, province.Code, province.Name, provinceRegion, pinyins)
+
+		for _, city := range province.Children {
+			cityRegion := provinceRegion
+			cityRegion.CityCode, cityRegion.CityName = city.Code, city.Name
+			// "市辖区"等占位名称不是真实地名，不参与搜索
+			if !placeholderCities[city.Name] {
+				s.add(LevelCity, city.Code, city.Name, cityRegion, pinyins)
+			}
+
+			for _, district := range city.Children {
+				districtRegion := cityRegion
+				districtRegion.DistrictCode, districtRegion.DistrictName = district.Code, district.Name
+				s.add(LevelDistrict, district.Code, district.Name, districtRegion, pinyins)
+			}
+		}
+	}
+
+	sort.Slice(s.entries, func(i, j int) bool {
+		return s.entries[i].result.Code < s.entries[j].result.Code
+	})
+	return s
+}
+
+func (s *Searcher) add(level Level, code string, name string, region Region, pinyins map[string]string) {
+	runes := []rune(name)
+	syllables := strings.Fields(strings.ReplaceAll(pinyins[code], "v", "u"))
+	if len(syllables) != len(runes) {
+		// 缺少拼音时只支持汉字搜索
+		syllables = nil
+	}
+
+	shortLen := len(runes)
+	if aliases := shortNames(name); len(aliases) > 0 {
+		shortLen = len([]rune(aliases[0]))
+	}
+
+	s.entries = append(s.entries, entry{
+		result: SearchResult{
+			Region: region,
+			Level:  level,
+			Code:   code,
+			Name:   name,
+			Pinyin: pinyins[code],
+		},
+		runes:     runes,
+		syllables: syllables,
+		shortLen:  shortLen,
+	})
+}
+
+// Search 按匹配度、级别、编码排序返回最多 limit 条结果，limit 小于等于 0 时不限制
+func (s *Searcher) Search(query string, limit int) []SearchResult {
+	q := normalizeQuery(query)
+	if len(q) == 0 {
+		return nil
+	}
+
+	var results []SearchResult
+	for i := range s.entries {
+		if match := s.entries[i].match(q); match > 0 {
+			result := s.entries[i].result
+			result.Match = match
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Match != results[j].Match {
+			return results[i].Match > results[j].Match
+		}
+		return results[i].Level < results[j].Level
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// normalizeQuery 转为小写并去掉空白和隔音符号，如"Xi'an" -> "xian"，ü 统一为 u
+func normalizeQuery(query string) []rune {
+	var q []rune
+	for _, r := range strings.ToLower(query) {
+		switch {
+		case unicode.IsSpace(r) || r == '\'':
+			continue
+		case r == 'v' || r == 'ü':
+			r = 'u'
+		}
+		q = append(q, r)
+	}
+	return q
+}
+
+// segment 输入中的一段与一个字的匹配方式
+type segment int
+
+const (
+	segmentHan     segment = 1 << iota // 汉字
+	segmentFull                        // 完整音节
+	segmentInitial                     // 声母或首字母
+	segmentPartial                     // 音节前缀，只允许出现在输入末尾
+)
+
+// parse 输入的一种切分方式
+type parse struct {
+	end  int     // 匹配到的字数
+	body segment // 除最后一段外各段匹配方式的并集
+	last segment // 最后一段的匹配方式
+}
+
+// match 返回 q 与区划名称的最佳匹配方式，不匹配时返回 0
+func (e *entry) match(q []rune) MatchType {
+	best := MatchType(0)
+	memo := make(map[[2]int][]parse)
+	for _, p := range e.parse(q, 0, 0, memo) {
+		if m := e.classify(p); m > best {
+			best = m
+		}
+	}
+	if best == 0 && strings.Contains(string(e.runes), string(q)) {
+		best = MatchContains
+	}
+	return best
+}
+
+// parse 从第 idx 个字开始匹配 q[pos:]，返回所有能完整消费输入的切分方式
+func (e *entry) parse(q []rune, pos, idx int, memo map[[2]int][]parse) []parse {
+	if idx >= len(e.runes) {
+		return nil
+	}
+	key := [2]int{pos, idx}
+	if parses, ok := memo[key]; ok {
+		return parses
+	}
+
+	var parses []parse
+	next := func(n int, seg segment) {
+		if pos+n == len(q) {
+			parses = append(parses, parse{end: idx + 1, last: seg})
+			return
+		}
+		if seg == segmentPartial {
+			return
+		}
+		for _, p := range e.parse(q, pos+n, idx+1, memo) {
+			parses = append(parses, parse{end: p.end, body: p.body | seg, last: p.last})
+		}
+	}
+
+	if q[pos] == e.runes[idx] {
+		next(1, segmentHan)
+	}
+	if e.syllables != nil {
+		syllable := []rune(e.syllables[idx])
+		for n := 1; n <= len(syllable) && pos+n <= len(q); n++ {
+			if string(q[pos:pos+n]) != string(syllable[:n]) {
+				break
+			}
+			switch {
+			case n == len(syllable):
+				next(n, segmentFull)
+			case n == 1 || (n == 2 && syllable[1] == 'h' && strings.ContainsRune("zcs", syllable[0])):
+				next(n, segmentInitial)
+			default:
+				next(n, segmentPartial)
+			}
+		}
+	}
+
+	memo[key] = parses
+	return parses
+}
+
+// classify 根据切分方式判断匹配类型
+func (e *entry) classify(p parse) MatchType {
+	complete := p.last != segmentPartial && (p.end == len(e.runes) || p.end == e.shortLen)
+	switch {
+	case p.body|p.last == segmentHan:
+		if complete {
+			return MatchExact
+		}
+		return MatchPrefix
+	case p.body|p.last == segmentFull:
+		if complete {
+			return MatchPinyin
+		}
+		return MatchPinyinPrefix
+	case p.body|p.last == segmentInitial:
+		if complete {
+			return MatchInitials
+		}
+		return MatchInitialsPrefix
+	case p.body&^segmentFull == 0 && p.last&(segmentPartial|segmentInitial) != 0:
+		// 最后一个音节尚未输入完整，如"hangzh"
+		return MatchPinyinPrefix
+	}
+	return MatchMixed
+}
+
+// Search 使用当前生效的数据集搜索省市区
+func Search(query string, limit int) []SearchResult {
+	return Current().Search(query, limit)
+}
@@ -0,0 +1,68 @@
+package area
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		code     string // 期望排在第一位的区划
+		match    MatchType
+		included []string // 期望出现在结果中的其他区划
+	}{
+		{name: "完整名称", query: "杭州市", code: "3301", match: MatchExact},
+		{name: "简称", query: "杭州", code: "3301", match: MatchExact},
+		{name: "汉字前缀", query: "杭", code: "3301", match: MatchPrefix},
+		{name: "全拼", query: "hangzhou", code: "3301", match: MatchPinyin},
+		{name: "全拼大写带空格", query: "Hang Zhou", code: "3301", match: MatchPinyin},
+		{name: "全拼前缀", query: "hangzh", code: "3301", match: MatchPinyinPrefix},
+		{name: "首字母按级别排序", query: "zj", code: "33", match: MatchInitials, included: []string{"3211", "4408"}},
+		{name: "首字母", query: "hz", code: "3301", match: MatchInitials, included: []string{"3305", "4413", "3717"}},
+		{name: "汉字与拼音混合", query: "hang州", code: "3301", match: MatchMixed},
+		{name: "首字母与全拼混合", query: "hzhou", code: "3301", match: MatchMixed},
+		{name: "多音字重庆", query: "chongqing", code: "50", match: MatchPinyin},
+		{name: "多音字厦门", query: "xiamen", code: "3502", match: MatchPinyin},
+		{name: "多音字六安", query: "luan", code: "3415", match: MatchPinyin},
+		{name: "隔音符号", query: "xi'an", code: "6101", match: MatchPinyin},
+		{name: "省份优先于同名区县", query: "jilin", code: "22", match: MatchPinyin, included: []string{"2202"}},
+		{name: "包含关键字", query: "湖区", code: "140802", match: MatchContains, included: []string{"330106"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := Search(tt.query, 0)
+			require.NotEmpty(t, results)
+			assert.Equal(t, tt.code, results[0].Code)
+			assert.Equal(t, tt.match, results[0].Match)
+
+			codes := make([]string, 0, len(results))
+			for _, r := range results {
+				codes = append(codes, r.Code)
+			}
+			for _, code := range tt.included {
+				assert.Contains(t, codes, code)
+			}
+		})
+	}
+}
+
+func TestSearchExcludesPlaceholder(t *testing.T) {
+	for _, r := range Search("shixiaqu", 0) {
+		assert.NotEqual(t, "市辖区", r.Name)
+	}
+	assert.Empty(t, Search("  ", 10))
+	assert.Empty(t, Search("xyz123", 10))
+}
+
+func TestSearchLimit(t *testing.T) {
+	results := Search("h", 5)
+	assert.Len(t, results, 5)
+	for i := 1; i < len(results); i++ {
+		assert.LessOrEqual(t, results[i-1].Level, results[i].Level)
+	}
+}
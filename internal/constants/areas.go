@@ -3,8 +3,13 @@ package constants
 import (
 	_ "embed"
 	"encoding/json"
+	"sort"
 )
 
+// ProvinceMap、CityMap、DistrictMap、AreaSuccessors 及 PinyinMap 由 gen/main.go 根据 areas.json、area_names.json 和 area_successors.json 生成，
+// 修改数据源后需要重新执行 go generate
+//go:generate go run ./gen
+
 //go:embed areas.json
 var areasJSON []byte
 
@@ -21,15 +26,21 @@ type AreaItem struct {
 	ProvinceCode string `json:"provinceCode"`
 }
 
+// Areas 以省份编码为 key 的三级省市区结构
+type Areas map[string]Area
+
 // 解析JSON数据并构建三级结构
-func parseAreasData() map[string]Area {
+func parseAreasData() Areas {
 	var items []AreaItem
 	if err := json.Unmarshal(areasJSON, &items); err != nil {
 		panic("解析areas.json失败: " + err.Error())
 	}
+	return BuildAreas(items, ProvinceMap, CityMap)
+}
 
-	// 构建三级结构
-	areaMap := make(map[string]Area)
+// BuildAreas 根据区县列表及省份、城市名称构建三级结构，children 按编码升序排列
+func BuildAreas(items []AreaItem, provinceNames, cityNames map[string]string) Areas {
+	areaMap := make(Areas)
 
 	// 按省份分组
 	provinceMap := make(map[string]map[string][]AreaItem)
@@ -44,7 +55,7 @@ func parseAreasData() map[string]Area {
 	for provinceCode, cities := range provinceMap {
 		province := Area{
 			Code:     provinceCode,
-			Name:     getProvinceName(provinceCode),
+			Name:     provinceNames[provinceCode],
 			Children: make([]Area, 0),
 		}
 
@@ -52,7 +63,7 @@ func parseAreasData() map[string]Area {
 		for cityCode, districts := range cities {
 			city := Area{
 				Code:     cityCode,
-				Name:     getCityName(cityCode),
+				Name:     cityNames[cityCode],
 				Children: make([]Area, 0),
 			}
 
@@ -65,9 +76,11 @@ func parseAreasData() map[string]Area {
 				}
 				city.Children = append(city.Children, districtArea)
 			}
+			sortAreas(city.Children)
 
 			province.Children = append(province.Children, city)
 		}
+		sortAreas(province.Children)
 
 		areaMap[provinceCode] = province
 	}
@@ -75,209 +88,68 @@ func parseAreasData() map[string]Area {
 	return areaMap
 }
 
-// ProvinceMap 省份名称
-var ProvinceMap = map[string]string{
-	"11": "北京市",
-	"12": "天津市",
-	"13": "河北省",
-	"14": "山西省",
-	"15": "内蒙古自治区",
-	"21": "辽宁省",
-	"22": "吉林省",
-	"23": "黑龙江省",
-	"31": "上海市",
-	"32": "江苏省",
-	"33": "浙江省",
-	"34": "安徽省",
-	"35": "福建省",
-	"36": "江西省",
-	"37": "山东省",
-	"41": "河南省",
-	"42": "湖北省",
-	"43": "湖南省",
-	"44": "广东省",
-	"45": "广西壮族自治区",
-	"46": "海南省",
-	"50": "重庆市",
-	"51": "四川省",
-	"52": "贵州省",
-	"53": "云南省",
-	"54": "西藏自治区",
-	"61": "陕西省",
-	"62": "甘肃省",
-	"63": "青海省",
-	"64": "宁夏回族自治区",
-	"65": "新疆维吾尔自治区",
-	"71": "台湾省",
-	"81": "香港特别行政区",
-	"82": "澳门特别行政区",
+// sortAreas 按编码升序排列，保证每次启动 children 顺序一致
+func sortAreas(areas []Area) {
+	sort.Slice(areas, func(i, j int) bool {
+		return areas[i].Code < areas[j].Code
+	})
+}
+
+// AreaMap 使用embed嵌入的JSON数据构建的三级省市区结构
+var AreaMap = parseAreasData()
+
+// GetProvince 根据2位编码获取省份
+func GetProvince(code string) (Area, bool) {
+	return AreaMap.Province(code)
 }
 
-// 获取省份名称
-func getProvinceName(code string) string {
-	return ProvinceMap[code]
+// GetCity 根据4位编码获取城市
+func GetCity(code string) (Area, bool) {
+	return AreaMap.City(code)
 }
 
-// CityMap 城市名称
-var CityMap = map[string]string{
-	"1101": "市辖区",
-	"1201": "市辖区",
-	"1301": "石家庄市",
-	"1302": "唐山市",
-	"1303": "秦皇岛市",
-	"1304": "邯郸市",
-	"1305": "邢台市",
-	"1306": "保定市",
-	"1307": "张家口市",
-	"1308": "承德市",
-	"1309": "沧州市",
-	"1310": "廊坊市",
-	"1311": "衡水市",
-	"1401": "太原市",
-	"1402": "大同市",
-	"1403": "阳泉市",
-	"1404": "长治市",
-	"1405": "晋城市",
-	"1406": "朔州市",
-	"1407": "晋中市",
-	"1408": "运城市",
-	"1409": "忻州市",
-	"1410": "临汾市",
-	"1411": "吕梁市",
-	"1501": "呼和浩特市",
-	"1502": "包头市",
-	"1503": "乌海市",
-	"1504": "赤峰市",
-	"1505": "通辽市",
-	"1506": "鄂尔多斯市",
-	"1507": "呼伦贝尔市",
-	"1508": "巴彦淖尔市",
-	"1509": "乌兰察布市",
-	"1522": "兴安盟",
-	"1525": "锡林郭勒盟",
-	"1529": "阿拉善盟",
-	"2101": "沈阳市",
-	"2102": "大连市",
-	"2103": "鞍山市",
-	"2104": "抚顺市",
-	"2105": "本溪市",
-	"2106": "丹东市",
-	"2107": "锦州市",
-	"2108": "营口市",
-	"2109": "阜新市",
-	"2110": "辽阳市",
-	"2111": "盘锦市",
-	"2112": "铁岭市",
-	"2113": "朝阳市",
-	"2114": "葫芦岛市",
-	"2201": "长春市",
-	"2202": "吉林市",
-	"2203": "四平市",
-	"2204": "辽源市",
-	"2205": "通化市",
-	"2206": "白山市",
-	"2207": "松原市",
-	"2208": "白城市",
-	"2224": "延边朝鲜族自治州",
-	"2301": "哈尔滨市",
-	"2302": "齐齐哈尔市",
-	"2303": "鸡西市",
-	"2304": "鹤岗市",
-	"2305": "双鸭山市",
-	"2306": "大庆市",
-	"2307": "伊春市",
-	"2308": "佳木斯市",
-	"2309": "七台河市",
-	"2310": "牡丹江市",
-	"2311": "黑河市",
-	"2312": "绥化市",
-	"2327": "大兴安岭地区",
-	"3101": "市辖区",
-	"3201": "南京市",
-	"3202": "无锡市",
-	"3203": "徐州市",
-	"3204": "常州市",
-	"3205": "苏州市",
-	"3206": "南通市",
-	"3207": "连云港市",
-	"3208": "淮安市",
-	"3209": "盐城市",
-	"3210": "扬州市",
-	"3211": "镇江市",
-	"3212": "泰州市",
-	"3213": "宿迁市",
-	"3301": "杭州市",
-	"3302": "宁波市",
-	"3303": "温州市",
-	"3304": "嘉兴市",
-	"3305": "湖州市",
-	"3306": "绍兴市",
-	"3307": "金华市",
-	"3308": "衢州市",
-	"3309": "舟山市",
-	"3310": "台州市",
-	"3311": "丽水市",
-	"3401": "合肥市",
-	"3402": "芜湖市",
-	"3403": "蚌埠市",
-	"3404": "淮南市",
-	"3405": "马鞍山市",
-	"3406": "淮北市",
-	"3407": "铜陵市",
-	"3408": "安庆市",
-	"3410": "黄山市",
-	"3411": "滁州市",
-	"3412": "阜阳市",
-	"3413": "宿州市",
-	"3415": "六安市",
-	"3416": "亳州市",
-	"3417": "池州市",
-	"3418": "宣城市",
-	"3501": "福州市",
-	"3502": "厦门市",
-	"3503": "莆田市",
-	"3504": "三明市",
-	"3505": "泉州市",
-	"3506": "漳州市",
-	"3507": "南平市",
-	"3508": "龙岩市",
-	"3509": "宁德市",
-	"3601": "南昌市",
-	"3602": "景德镇市",
-	"3603": "萍乡市",
-	"3604": "九江市",
-	"3605": "新余市",
-	"3606": "鹰潭市",
-	"3607": "赣州市",
-	"3608": "吉安市",
-	"3609": "宜春市",
-	"3610": "抚州市",
-	"3611": "上饶市",
-	"3701": "济南市",
-	"3702": "青岛市",
-	"3703": "淄博市",
-	"3704": "枣庄市",
-	"3705": "东营市",
-	"3706": "烟台市",
-	"3707": "潍坊市",
-	"3708": "济宁市",
-	"3709": "泰安市",
-	"3710": "威海市",
-	"3711": "日照市",
-	"3713": "临沂市",
-	"3714": "德州市",
-	"3715": "聊城市",
-	"3716": "滨州市",
-	"3717": "菏泽市",
-	"4101": "郑州市",
-	"4102": "开封市",
-	"4103": "洛阳市",
+// GetDistrict 根据6位编码获取区县
+func GetDistrict(code string) (Area, bool) {
+	return AreaMap.District(code)
 }
 
-// 获取城市名称
-func getCityName(code string) string {
-	return CityMap[code]
+// Province 根据2位编码获取省份
+func (a Areas) Province(code string) (Area, bool) {
+	province, ok := a[code]
+	return province, ok
 }
 
-// AreaMap 使用embed嵌入的JSON数据构建的三级省市区结构
-var AreaMap = parseAreasData()
+// City 根据4位编码获取城市
+func (a Areas) City(code string) (Area, bool) {
+	if len(code) != 4 {
+		return Area{}, false
+	}
+	province, ok := a.Province(code[:2])
+	if !ok {
+		return Area{}, false
+	}
+	return searchArea(province.Children, code)
+}
+
+// District 根据6位编码获取区县
+func (a Areas) District(code string) (Area, bool) {
+	if len(code) != 6 {
+		return Area{}, false
+	}
+	city, ok := a.City(code[:4])
+	if !ok {
+		return Area{}, false
+	}
+	return searchArea(city.Children, code)
+}
+
+// searchArea 在按编码排序的 areas 中二分查找
+func searchArea(areas []Area, code string) (Area, bool) {
+	i := sort.Search(len(areas), func(i int) bool {
+		return areas[i].Code >= code
+	})
+	if i < len(areas) && areas[i].Code == code {
+		return areas[i], true
+	}
+	return Area{}, false
+}
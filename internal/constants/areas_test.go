@@ -0,0 +1,101 @@
+package constants
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAreaMapNames(t *testing.T) {
+	var items []AreaItem
+	require.NoError(t, json.Unmarshal(areasJSON, &items))
+
+	// 数据源中的每个编码都要能解析出名称
+	for _, item := range items {
+		assert.NotEmpty(t, ProvinceMap[item.ProvinceCode], "province %s", item.ProvinceCode)
+		assert.NotEmpty(t, CityMap[item.CityCode], "city %s", item.CityCode)
+		assert.Equal(t, item.Name, DistrictMap[item.Code], "district %s", item.Code)
+	}
+
+	districtCount := 0
+	for code, province := range AreaMap {
+		assert.Equal(t, code, province.Code)
+		assert.Equal(t, ProvinceMap[code], province.Name)
+		assert.NotEmpty(t, province.Name, "province %s", code)
+		for _, city := range province.Children {
+			assert.NotEmpty(t, city.Name, "city %s", city.Code)
+			assert.Equal(t, CityMap[city.Code], city.Name)
+			for _, district := range city.Children {
+				assert.NotEmpty(t, district.Name, "district %s", district.Code)
+				assert.Equal(t, DistrictMap[district.Code], district.Name)
+				districtCount++
+			}
+		}
+	}
+	assert.Equal(t, len(items), districtCount)
+	assert.Equal(t, len(DistrictMap), districtCount)
+}
+
+func TestAreaMapSorted(t *testing.T) {
+	isSorted := func(areas []Area) bool {
+		return sort.SliceIsSorted(areas, func(i, j int) bool {
+			return areas[i].Code < areas[j].Code
+		})
+	}
+
+	for _, province := range AreaMap {
+		assert.True(t, isSorted(province.Children), "province %s", province.Code)
+		for _, city := range province.Children {
+			assert.True(t, isSorted(city.Children), "city %s", city.Code)
+		}
+	}
+}
+
+func TestAreaNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{name: "洛阳之后的河南城市", code: "4104", expected: "平顶山市"},
+		{name: "省直辖县级行政区划", code: "4290", expected: "省直辖县级行政区划"},
+		{name: "自治州", code: "5134", expected: "凉山彝族自治州"},
+		{name: "最后一个城市", code: "6590", expected: "自治区直辖县级行政区划"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CityMap[tt.code])
+		})
+	}
+}
+
+func TestGetArea(t *testing.T) {
+	tests := []struct {
+		name     string
+		get      func(string) (Area, bool)
+		code     string
+		expected string
+		ok       bool
+	}{
+		{name: "省份", get: GetProvince, code: "33", expected: "浙江省", ok: true},
+		{name: "城市", get: GetCity, code: "3301", expected: "杭州市", ok: true},
+		{name: "区县", get: GetDistrict, code: "330106", expected: "西湖区", ok: true},
+		{name: "不存在的省份", get: GetProvince, code: "99"},
+		{name: "不存在的城市", get: GetCity, code: "3399"},
+		{name: "城市编码长度错误", get: GetCity, code: "330"},
+		{name: "不存在的区县", get: GetDistrict, code: "330199"},
+		{name: "区县编码长度错误", get: GetDistrict, code: "3301"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area, ok := tt.get(tt.code)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, area.Name)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package constants
+
+import (
+	"github.com/jessewkun/gocommon/common"
+	"github.com/jessewkun/gocommon/constant"
+)
+
+// 客户端信息在 context 中的 key，由 middleware.ClientVersion 设置，注册为需要传播的 key，日志中会带上这些字段
+const (
+	CtxDeviceID      constant.ContextKey = "device_id"
+	CtxPlatform      constant.ContextKey = "platform"
+	CtxClientVersion constant.ContextKey = "client_version"
+)
+
+func init() {
+	common.RegisterPropagatedContextKey(CtxDeviceID)
+	common.RegisterPropagatedContextKey(CtxPlatform)
+	common.RegisterPropagatedContextKey(CtxClientVersion)
+}
@@ -0,0 +1,285 @@
+// gen 根据 areas.json、area_names.json 和 area_successors.json 生成省市区名称表 areas_gen.go 及拼音表 pinyin_gen.go
+//
+// 使用方式：在 internal/constants 目录下执行 go generate
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+const (
+	areasFile      = "areas.json"
+	namesFile      = "area_names.json"
+	successorsFile = "area_successors.json"
+	outputFile     = "areas_gen.go"
+	pinyinFile     = "pinyin_gen.go"
+)
+
+// 地名中的多音字，go-pinyin 默认读音与地名读音不一致时以此为准
+var charPinyin = map[rune]string{
+	'什': "shi",   // 喀什、什邡
+	'伽': "jia",   // 伽师
+	'佛': "fo",    // 佛山
+	'勒': "le",    // 库尔勒、弥勒
+	'厦': "xia",   // 厦门
+	'圩': "xu",    // 龙圩
+	'坻': "di",    // 宝坻
+	'堡': "bu",    // 吴堡、红寺堡
+	'峒': "tong",  // 崆峒
+	'曾': "zeng",  // 曾都
+	'枞': "zong",  // 枞阳
+	'泊': "bo",    // 泊头
+	'泌': "bi",    // 泌阳
+	'浚': "xun",   // 浚县
+	'涡': "guo",   // 涡阳
+	'牟': "mu",    // 中牟、牟平
+	'犍': "qian",  // 犍为
+	'珲': "hun",   // 珲春
+	'称': "chen",  // 称多
+	'筠': "jun",   // 筠连
+	'茄': "qie",   // 茄子河
+	'蔚': "yu",    // 蔚县
+	'藏': "zang",  // 西藏
+	'蚌': "beng",  // 蚌埠
+	'覃': "qin",   // 覃塘
+	'都': "du",    // 成都
+	'重': "chong", // 重庆
+	'长': "chang", // 长沙
+	'陂': "pi",    // 黄陂
+}
+
+// 同一个字在不同地名中读音不同时，按词语指定读音
+var phrasePinyin = map[string]string{
+	"长子": "zhang zi",
+	"闵行": "min hang",
+	"六安": "lu an",
+	"六合": "lu he",
+	"番禺": "pan yu",
+	"铅山": "yan shan",
+	"乐清": "yue qing",
+	"乐亭": "lao ting",
+	"大埔": "da bu",
+	"荥经": "ying jing",
+	"单县": "shan xian",
+	"东阿": "dong e",
+	"洪洞": "hong tong",
+	"繁峙": "fan shi",
+	"尉犁": "yu li",
+	"牟定": "mou ding",
+	"召陵": "shao ling",
+	"穆棱": "mu ling",
+}
+
+type areaItem struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	CityCode     string `json:"cityCode"`
+	ProvinceCode string `json:"provinceCode"`
+}
+
+type nameItem struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type successorItem struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Successor string `json:"successor"`
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var areas []areaItem
+	if err := readJSON(areasFile, &areas); err != nil {
+		return err
+	}
+	var names []nameItem
+	if err := readJSON(namesFile, &names); err != nil {
+		return err
+	}
+
+	provinces := make(map[string]string)
+	cities := make(map[string]string)
+	for _, item := range names {
+		if item.Name == "" {
+			return fmt.Errorf("%s: code %s has empty name", namesFile, item.Code)
+		}
+		switch len(item.Code) {
+		case 2:
+			provinces[item.Code] = item.Name
+		case 4:
+			cities[item.Code] = item.Name
+		default:
+			return fmt.Errorf("%s: invalid code %q", namesFile, item.Code)
+		}
+	}
+	for code := range cities {
+		if _, ok := provinces[code[:2]]; !ok {
+			return fmt.Errorf("%s: city %s has no province", namesFile, code)
+		}
+	}
+
+	// 校验区县数据与名称表一致，保证生成后每个编码都能解析出名称
+	districts := make(map[string]string, len(areas))
+	for _, item := range areas {
+		if len(item.Code) != 6 || item.Name == "" {
+			return fmt.Errorf("%s: invalid district %q(%q)", areasFile, item.Code, item.Name)
+		}
+		if item.CityCode != item.Code[:4] || item.ProvinceCode != item.Code[:2] {
+			return fmt.Errorf("%s: district %s has mismatched parent codes", areasFile, item.Code)
+		}
+		if _, ok := districts[item.Code]; ok {
+			return fmt.Errorf("%s: duplicate district %s", areasFile, item.Code)
+		}
+		if _, ok := provinces[item.ProvinceCode]; !ok {
+			return fmt.Errorf("%s: missing province name for %s", namesFile, item.ProvinceCode)
+		}
+		if _, ok := cities[item.CityCode]; !ok {
+			return fmt.Errorf("%s: missing city name for %s", namesFile, item.CityCode)
+		}
+		districts[item.Code] = item.Name
+	}
+
+	// 已撤销的编码不能出现在当前数据中，继任编码必须存在
+	var retired []successorItem
+	if err := readJSON(successorsFile, &retired); err != nil {
+		return err
+	}
+	successors := make(map[string]string, len(retired))
+	for _, item := range retired {
+		if provinces[item.Code] != "" || cities[item.Code] != "" || districts[item.Code] != "" {
+			return fmt.Errorf("%s: retired code %s still exists", successorsFile, item.Code)
+		}
+		if provinces[item.Successor] == "" && cities[item.Successor] == "" && districts[item.Successor] == "" {
+			return fmt.Errorf("%s: successor %s of %s not found", successorsFile, item.Successor, item.Code)
+		}
+		successors[item.Code] = item.Successor
+	}
+
+	version, err := dataVersion(areasFile, namesFile, successorsFile)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen/main.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package constants\n")
+	fmt.Fprintf(&buf, "\n// AreaVersion 内置省市区数据的版本，由数据源文件内容计算得出\nconst AreaVersion = %q\n", version)
+	writeMap(&buf, "ProvinceMap", "省份名称", provinces)
+	writeMap(&buf, "CityMap", "城市名称", cities)
+	writeMap(&buf, "DistrictMap", "区县名称", districts)
+	writeMap(&buf, "AreaSuccessors", "已撤销的区划编码及其继任编码", successors)
+
+	if err := writeSource(outputFile, buf.Bytes()); err != nil {
+		return err
+	}
+
+	pinyins := make(map[string]string, len(provinces)+len(cities)+len(districts))
+	for _, m := range []map[string]string{provinces, cities, districts} {
+		for code, name := range m {
+			py, err := namePinyin(name)
+			if err != nil {
+				return fmt.Errorf("code %s: %w", code, err)
+			}
+			pinyins[code] = py
+		}
+	}
+
+	buf.Reset()
+	buf.WriteString("// Code generated by gen/main.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package constants\n")
+	writeMap(&buf, "PinyinMap", "省市区名称的拼音，key 为省市区编码，音节之间以空格分隔", pinyins)
+	return writeSource(pinyinFile, buf.Bytes())
+}
+
+// namePinyin 返回地名的不带声调拼音，按 phrasePinyin、charPinyin、go-pinyin 默认读音的优先级确定多音字读音
+func namePinyin(name string) (string, error) {
+	runes := []rune(name)
+	syllables := make([]string, len(runes))
+	args := pinyin.NewArgs()
+	for i, r := range runes {
+		if py, ok := charPinyin[r]; ok {
+			syllables[i] = py
+			continue
+		}
+		readings := pinyin.SinglePinyin(r, args)
+		if len(readings) == 0 {
+			return "", fmt.Errorf("no pinyin for %q in %s", r, name)
+		}
+		syllables[i] = readings[0]
+	}
+
+	for phrase, py := range phrasePinyin {
+		offset := strings.Index(name, phrase)
+		if offset < 0 {
+			continue
+		}
+		start := len([]rune(name[:offset]))
+		copy(syllables[start:], strings.Fields(py))
+	}
+	return strings.Join(syllables, " "), nil
+}
+
+// dataVersion 根据数据源文件内容计算版本号，数据不变时版本号保持不变
+func dataVersion(files ...string) (string, error) {
+	h := sha256.New()
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", file, err)
+		}
+		h.Write(data)
+	}
+	return "builtin-" + hex.EncodeToString(h.Sum(nil))[:12], nil
+}
+
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeMap 按编码升序输出 map 字面量，保证生成结果稳定
+func writeMap(buf *bytes.Buffer, name string, comment string, m map[string]string) {
+	codes := make([]string, 0, len(m))
+	for code := range m {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	fmt.Fprintf(buf, "\n// %s %s\nvar %s = map[string]string{\n", name, comment, name)
+	for _, code := range codes {
+		fmt.Fprintf(buf, "%q: %q,\n", code, m[code])
+	}
+	buf.WriteString("}\n")
+}
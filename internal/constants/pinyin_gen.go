@@ -0,0 +1,3361 @@
+// Code generated by gen/main.go; DO NOT EDIT.
+
+package constants
+
+// PinyinMap 省市区名称的拼音，key 为省市区编码，音节之间以空格分隔
+var PinyinMap = map[string]string{
+	"11":     "bei jing shi",
+	"1101":   "shi xia qu",
+	"110101": "dong cheng qu",
+	"110102": "xi cheng qu",
+	"110105": "chao yang qu",
+	"110106": "feng tai qu",
+	"110107": "shi jing shan qu",
+	"110108": "hai dian qu",
+	"110109": "men tou gou qu",
+	"110111": "fang shan qu",
+	"110112": "tong zhou qu",
+	"110113": "shun yi qu",
+	"110114": "chang ping qu",
+	"110115": "da xing qu",
+	"110116": "huai rou qu",
+	"110117": "ping gu qu",
+	"110118": "mi yun qu",
+	"110119": "yan qing qu",
+	"12":     "tian jin shi",
+	"1201":   "shi xia qu",
+	"120101": "he ping qu",
+	"120102": "he dong qu",
+	"120103": "he xi qu",
+	"120104": "nan kai qu",
+	"120105": "he bei qu",
+	"120106": "hong qiao qu",
+	"120110": "dong li qu",
+	"120111": "xi qing qu",
+	"120112": "jin nan qu",
+	"120113": "bei chen qu",
+	"120114": "wu qing qu",
+	"120115": "bao di qu",
+	"120116": "bin hai xin qu",
+	"120117": "ning he qu",
+	"120118": "jing hai qu",
+	"120119": "ji zhou qu",
+	"13":     "he bei sheng",
+	"1301":   "shi jia zhuang shi",
+	"130102": "chang an qu",
+	"130104": "qiao xi qu",
+	"130105": "xin hua qu",
+	"130107": "jing xing kuang qu",
+	"130108": "yu hua qu",
+	"130109": "gao cheng qu",
+	"130110": "lu quan qu",
+	"130111": "luan cheng qu",
+	"130121": "jing xing xian",
+	"130123": "zheng ding xian",
+	"130125": "xing tang xian",
+	"130126": "ling shou xian",
+	"130127": "gao yi xian",
+	"130128": "shen ze xian",
+	"130129": "zan huang xian",
+	"130130": "wu ji xian",
+	"130131": "ping shan xian",
+	"130132": "yuan shi xian",
+	"130133": "zhao xian",
+	"130171": "shi jia zhuang gao xin ji shu chan ye kai fa qu",
+	"130172": "shi jia zhuang xun huan hua gong yuan qu",
+	"130181": "xin ji shi",
+	"130183": "jin zhou shi",
+	"130184": "xin le shi",
+	"1302":   "tang shan shi",
+	"130202": "lu nan qu",
+	"130203": "lu bei qu",
+	"130204": "gu ye qu",
+	"130205": "kai ping qu",
+	"130207": "feng nan qu",
+	"130208": "feng run qu",
+	"130209": "cao fei dian qu",
+	"130224": "luan nan xian",
+	"130225": "lao ting xian",
+	"130227": "qian xi xian",
+	"130229": "yu tian xian",
+	"130271": "he bei tang shan lu tai jing ji kai fa qu",
+	"130272": "tang shan shi han gu guan li qu",
+	"130273": "tang shan gao xin ji shu chan ye kai fa qu",
+	"130274": "he bei tang shan hai gang jing ji kai fa qu",
+	"130281": "zun hua shi",
+	"130283": "qian an shi",
+	"130284": "luan zhou shi",
+	"1303":   "qin huang dao shi",
+	"130302": "hai gang qu",
+	"130303": "shan hai guan qu",
+	"130304": "bei dai he qu",
+	"130306": "fu ning qu",
+	"130321": "qing long man zu zi zhi xian",
+	"130322": "chang li xian",
+	"130324": "lu long xian",
+	"130371": "qin huang dao shi jing ji ji shu kai fa qu",
+	"130372": "bei dai he xin qu",
+	"1304":   "han dan shi",
+	"130402": "han shan qu",
+	"130403": "cong tai qu",
+	"130404": "fu xing qu",
+	"130406": "feng feng kuang qu",
+	"130407": "fei xiang qu",
+	"130408": "yong nian qu",
+	"130423": "lin zhang xian",
+	"130424": "cheng an xian",
+	"130425": "da ming xian",
+	"130426": "she xian",
+	"130427": "ci xian",
+	"130430": "qiu xian",
+	"130431": "ji ze xian",
+	"130432": "guang ping xian",
+	"130433": "guan tao xian",
+	"130434": "wei xian",
+	"130435": "qu zhou xian",
+	"130471": "han dan jing ji ji shu kai fa qu",
+	"130473": "han dan ji nan xin qu",
+	"130481": "wu an shi",
+	"1305":   "xing tai shi",
+	"130502": "xiang du qu",
+	"130503": "xin du qu",
+	"130505": "ren ze qu",
+	"130506": "nan he qu",
+	"130522": "lin cheng xian",
+	"130523": "nei qiu xian",
+	"130524": "bai xiang xian",
+	"130525": "long yao xian",
+	"130528": "ning jin xian",
+	"130529": "ju lu xian",
+	"130530": "xin he xian",
+	"130531": "guang zong xian",
+	"130532": "ping xiang xian",
+	"130533": "wei xian",
+	"130534": "qing he xian",
+	"130535": "lin xi xian",
+	"130571": "he bei xing tai jing ji kai fa qu",
+	"130581": "nan gong shi",
+	"130582": "sha he shi",
+	"1306":   "bao ding shi",
+	"130602": "jing xiu qu",
+	"130606": "lian chi qu",
+	"130607": "man cheng qu",
+	"130608": "qing yuan qu",
+	"130609": "xu shui qu",
+	"130623": "lai shui xian",
+	"130624": "fu ping xian",
+	"130626": "ding xing xian",
+	"130627": "tang xian",
+	"130628": "gao yang xian",
+	"130629": "rong cheng xian",
+	"130630": "lai yuan xian",
+	"130631": "wang du xian",
+	"130632": "an xin xian",
+	"130633": "yi xian",
+	"130634": "qu yang xian",
+	"130635": "li xian",
+	"130636": "shun ping xian",
+	"130637": "bo ye xian",
+	"130638": "xiong xian",
+	"130671": "bao ding gao xin ji shu chan ye kai fa qu",
+	"130672": "bao ding bai gou xin cheng",
+	"130681": "zhuo zhou shi",
+	"130682": "ding zhou shi",
+	"130683": "an guo shi",
+	"130684": "gao bei dian shi",
+	"1307":   "zhang jia kou shi",
+	"130702": "qiao dong qu",
+	"130703": "qiao xi qu",
+	"130705": "xuan hua qu",
+	"130706": "xia hua yuan qu",
+	"130708": "wan quan qu",
+	"130709": "chong li qu",
+	"130722": "zhang bei xian",
+	"130723": "kang bao xian",
+	"130724": "gu yuan xian",
+	"130725": "shang yi xian",
+	"130726": "yu xian",
+	"130727": "yang yuan xian",
+	"130728": "huai an xian",
+	"130730": "huai lai xian",
+	"130731": "zhuo lu xian",
+	"130732": "chi cheng xian",
+	"130771": "zhang jia kou jing ji kai fa qu",
+	"130772": "zhang jia kou shi cha bei guan li qu",
+	"130773": "zhang jia kou shi sai bei guan li qu",
+	"1308":   "cheng de shi",
+	"130802": "shuang qiao qu",
+	"130803": "shuang luan qu",
+	"130804": "ying shou ying zi kuang qu",
+	"130821": "cheng de xian",
+	"130822": "xing long xian",
+	"130824": "luan ping xian",
+	"130825": "long hua xian",
+	"130826": "feng ning man zu zi zhi xian",
+	"130827": "kuan cheng man zu zi zhi xian",
+	"130828": "wei chang man zu meng gu zu zi zhi xian",
+	"130871": "cheng de gao xin ji shu chan ye kai fa qu",
+	"130881": "ping quan shi",
+	"1309":   "cang zhou shi",
+	"130902": "xin hua qu",
+	"130903": "yun he qu",
+	"130921": "cang xian",
+	"130922": "qing xian",
+	"130923": "dong guang xian",
+	"130924": "hai xing xian",
+	"130925": "yan shan xian",
+	"130926": "su ning xian",
+	"130927": "nan pi xian",
+	"130928": "wu qiao xian",
+	"130929": "xian xian",
+	"130930": "meng cun hui zu zi zhi xian",
+	"130971": "he bei cang zhou jing ji kai fa qu",
+	"130972": "cang zhou gao xin ji shu chan ye kai fa qu",
+	"130973": "cang zhou bo hai xin qu",
+	"130981": "bo tou shi",
+	"130982": "ren qiu shi",
+	"130983": "huang hua shi",
+	"130984": "he jian shi",
+	"1310":   "lang fang shi",
+	"131002": "an ci qu",
+	"131003": "guang yang qu",
+	"131022": "gu an xian",
+	"131023": "yong qing xian",
+	"131024": "xiang he xian",
+	"131025": "da cheng xian",
+	"131026": "wen an xian",
+	"131028": "da chang hui zu zi zhi xian",
+	"131071": "lang fang jing ji ji shu kai fa qu",
+	"131081": "ba zhou shi",
+	"131082": "san he shi",
+	"1311":   "heng shui shi",
+	"131102": "tao cheng qu",
+	"131103": "ji zhou qu",
+	"131121": "zao qiang xian",
+	"131122": "wu yi xian",
+	"131123": "wu qiang xian",
+	"131124": "rao yang xian",
+	"131125": "an ping xian",
+	"131126": "gu cheng xian",
+	"131127": "jing xian",
+	"131128": "fu cheng xian",
+	"131171": "he bei heng shui gao xin ji shu chan ye kai fa qu",
+	"131172": "heng shui bin hu xin qu",
+	"131182": "shen zhou shi",
+	"14":     "shan xi sheng",
+	"1401":   "tai yuan shi",
+	"140105": "xiao dian qu",
+	"140106": "ying ze qu",
+	"140107": "xing hua ling qu",
+	"140108": "jian cao ping qu",
+	"140109": "wan bai lin qu",
+	"140110": "jin yuan qu",
+	"140121": "qing xu xian",
+	"140122": "yang qu xian",
+	"140123": "lou fan xian",
+	"140171": "shan xi zhuan xing zong he gai ge shi fan qu",
+	"140181": "gu jiao shi",
+	"1402":   "da tong shi",
+	"140212": "xin rong qu",
+	"140213": "ping cheng qu",
+	"140214": "yun gang qu",
+	"140215": "yun zhou qu",
+	"140221": "yang gao xian",
+	"140222": "tian zhen xian",
+	"140223": "guang ling xian",
+	"140224": "ling qiu xian",
+	"140225": "hun yuan xian",
+	"140226": "zuo yun xian",
+	"140271": "shan xi da tong jing ji kai fa qu",
+	"1403":   "yang quan shi",
+	"140302": "cheng qu",
+	"140303": "kuang qu",
+	"140311": "jiao qu",
+	"140321": "ping ding xian",
+	"140322": "yu xian",
+	"1404":   "chang zhi shi",
+	"140403": "lu zhou qu",
+	"140404": "shang dang qu",
+	"140405": "tun liu qu",
+	"140406": "lu cheng qu",
+	"140423": "xiang yuan xian",
+	"140425": "ping shun xian",
+	"140426": "li cheng xian",
+	"140427": "hu guan xian",
+	"140428": "zhang zi xian",
+	"140429": "wu xiang xian",
+	"140430": "qin xian",
+	"140431": "qin yuan xian",
+	"1405":   "jin cheng shi",
+	"140502": "cheng qu",
+	"140521": "qin shui xian",
+	"140522": "yang cheng xian",
+	"140524": "ling chuan xian",
+	"140525": "ze zhou xian",
+	"140581": "gao ping shi",
+	"1406":   "shuo zhou shi",
+	"140602": "shuo cheng qu",
+	"140603": "ping lu qu",
+	"140621": "shan yin xian",
+	"140622": "ying xian",
+	"140623": "you yu xian",
+	"140671": "shan xi shuo zhou jing ji kai fa qu",
+	"140681": "huai ren shi",
+	"1407":   "jin zhong shi",
+	"140702": "yu ci qu",
+	"140703": "tai gu qu",
+	"140721": "yu she xian",
+	"140722": "zuo quan xian",
+	"140723": "he shun xian",
+	"140724": "xi yang xian",
+	"140725": "shou yang xian",
+	"140727": "qi xian",
+	"140728": "ping yao xian",
+	"140729": "ling shi xian",
+	"140781": "jie xiu shi",
+	"1408":   "yun cheng shi",
+	"140802": "yan hu qu",
+	"140821": "lin yi xian",
+	"140822": "wan rong xian",
+	"140823": "wen xi xian",
+	"140824": "ji shan xian",
+	"140825": "xin jiang xian",
+	"140826": "jiang xian",
+	"140827": "yuan qu xian",
+	"140828": "xia xian",
+	"140829": "ping lu xian",
+	"140830": "rui cheng xian",
+	"140881": "yong ji shi",
+	"140882": "he jin shi",
+	"1409":   "xin zhou shi",
+	"140902": "xin fu qu",
+	"140921": "ding xiang xian",
+	"140922": "wu tai xian",
+	"140923": "dai xian",
+	"140924": "fan shi xian",
+	"140925": "ning wu xian",
+	"140926": "jing le xian",
+	"140927": "shen chi xian",
+	"140928": "wu zhai xian",
+	"140929": "ke lan xian",
+	"140930": "he qu xian",
+	"140931": "bao de xian",
+	"140932": "pian guan xian",
+	"140971": "wu tai shan feng jing ming sheng qu",
+	"140981": "yuan ping shi",
+	"1410":   "lin fen shi",
+	"141002": "yao du qu",
+	"141021": "qu wo xian",
+	"141022": "yi cheng xian",
+	"141023": "xiang fen xian",
+	"141024": "hong tong xian",
+	"141025": "gu xian",
+	"141026": "an ze xian",
+	"141027": "fu shan xian",
+	"141028": "ji xian",
+	"141029": "xiang ning xian",
+	"141030": "da ning xian",
+	"141031": "xi xian",
+	"141032": "yong he xian",
+	"141033": "pu xian",
+	"141034": "fen xi xian",
+	"141081": "hou ma shi",
+	"141082": "huo zhou shi",
+	"1411":   "lv liang shi",
+	"141102": "li shi qu",
+	"141121": "wen shui xian",
+	"141122": "jiao cheng xian",
+	"141123": "xing xian",
+	"141124": "lin xian",
+	"141125": "liu lin xian",
+	"141126": "shi lou xian",
+	"141127": "lan xian",
+	"141128": "fang shan xian",
+	"141129": "zhong yang xian",
+	"141130": "jiao kou xian",
+	"141181": "xiao yi shi",
+	"141182": "fen yang shi",
+	"15":     "nei meng gu zi zhi qu",
+	"1501":   "hu he hao te shi",
+	"150102": "xin cheng qu",
+	"150103": "hui min qu",
+	"150104": "yu quan qu",
+	"150105": "sai han qu",
+	"150121": "tu mo te zuo qi",
+	"150122": "tuo ke tuo xian",
+	"150123": "he lin ge er xian",
+	"150124": "qing shui he xian",
+	"150125": "wu chuan xian",
+	"150172": "hu he hao te jing ji ji shu kai fa qu",
+	"1502":   "bao tou shi",
+	"150202": "dong he qu",
+	"150203": "kun du lun qu",
+	"150204": "qing shan qu",
+	"150205": "shi guai qu",
+	"150206": "bai yun e bo kuang qu",
+	"150207": "jiu yuan qu",
+	"150221": "tu mo te you qi",
+	"150222": "gu yang xian",
+	"150223": "da er han mao ming an lian he qi",
+	"150271": "bao tou xi tu gao xin ji shu chan ye kai fa qu",
+	"1503":   "wu hai shi",
+	"150302": "hai bo wan qu",
+	"150303": "hai nan qu",
+	"150304": "wu da qu",
+	"1504":   "chi feng shi",
+	"150402": "hong shan qu",
+	"150403": "yuan bao shan qu",
+	"150404": "song shan qu",
+	"150421": "a lu ke er qin qi",
+	"150422": "ba lin zuo qi",
+	"150423": "ba lin you qi",
+	"150424": "lin xi xian",
+	"150425": "ke shi ke teng qi",
+	"150426": "weng niu te qi",
+	"150428": "ka la qin qi",
+	"150429": "ning cheng xian",
+	"150430": "ao han qi",
+	"1505":   "tong liao shi",
+	"150502": "ke er qin qu",
+	"150521": "ke er qin zuo yi zhong qi",
+	"150522": "ke er qin zuo yi hou qi",
+	"150523": "kai lu xian",
+	"150524": "ku lun qi",
+	"150525": "nai man qi",
+	"150526": "zha lu te qi",
+	"150571": "tong liao jing ji ji shu kai fa qu",
+	"150581": "huo lin guo le shi",
+	"1506":   "e er duo si shi",
+	"150602": "dong sheng qu",
+	"150603": "kang ba shi qu",
+	"150621": "da la te qi",
+	"150622": "zhun ge er qi",
+	"150623": "e tuo ke qian qi",
+	"150624": "e tuo ke qi",
+	"150625": "hang jin qi",
+	"150626": "wu shen qi",
+	"150627": "yi jin huo luo qi",
+	"1507":   "hu lun bei er shi",
+	"150702": "hai la er qu",
+	"150703": "zha lai nuo er qu",
+	"150721": "a rong qi",
+	"150722": "mo li da wa da wo er zu zi zhi qi",
+	"150723": "e lun chun zi zhi qi",
+	"150724": "e wen ke zu zi zhi qi",
+	"150725": "chen ba er hu qi",
+	"150726": "xin ba er hu zuo qi",
+	"150727": "xin ba er hu you qi",
+	"150781": "man zhou li shi",
+	"150782": "ya ke shi shi",
+	"150783": "zha lan tun shi",
+	"150784": "e er gu na shi",
+	"150785": "gen he shi",
+	"1508":   "ba yan nao er shi",
+	"150802": "lin he qu",
+	"150821": "wu yuan xian",
+	"150822": "deng kou xian",
+	"150823": "wu la te qian qi",
+	"150824": "wu la te zhong qi",
+	"150825": "wu la te hou qi",
+	"150826": "hang jin hou qi",
+	"1509":   "wu lan cha bu shi",
+	"150902": "ji ning qu",
+	"150921": "zhuo zi xian",
+	"150922": "hua de xian",
+	"150923": "shang du xian",
+	"150924": "xing he xian",
+	"150925": "liang cheng xian",
+	"150926": "cha ha er you yi qian qi",
+	"150927": "cha ha er you yi zhong qi",
+	"150928": "cha ha er you yi hou qi",
+	"150929": "si zi wang qi",
+	"150981": "feng zhen shi",
+	"1522":   "xing an meng",
+	"152201": "wu lan hao te shi",
+	"152202": "a er shan shi",
+	"152221": "ke er qin you yi qian qi",
+	"152222": "ke er qin you yi zhong qi",
+	"152223": "zha lai te qi",
+	"152224": "tu quan xian",
+	"1525":   "xi lin guo le meng",
+	"152501": "er lian hao te shi",
+	"152502": "xi lin hao te shi",
+	"152522": "a ba ga qi",
+	"152523": "su ni te zuo qi",
+	"152524": "su ni te you qi",
+	"152525": "dong wu zhu mu qin qi",
+	"152526": "xi wu zhu mu qin qi",
+	"152527": "tai pu si qi",
+	"152528": "xiang huang qi",
+	"152529": "zheng xiang bai qi",
+	"152530": "zheng lan qi",
+	"152531": "duo lun xian",
+	"152571": "wu la gai guan li qu guan wei hui",
+	"1529":   "a la shan meng",
+	"152921": "a la shan zuo qi",
+	"152922": "a la shan you qi",
+	"152923": "e ji na qi",
+	"152971": "nei meng gu a la shan gao xin ji shu chan ye kai fa qu",
+	"21":     "liao ning sheng",
+	"2101":   "shen yang shi",
+	"210102": "he ping qu",
+	"210103": "shen he qu",
+	"210104": "da dong qu",
+	"210105": "huang gu qu",
+	"210106": "tie xi qu",
+	"210111": "su jia tun qu",
+	"210112": "hun nan qu",
+	"210113": "shen bei xin qu",
+	"210114": "yu hong qu",
+	"210115": "liao zhong qu",
+	"210123": "kang ping xian",
+	"210124": "fa ku xian",
+	"210181": "xin min shi",
+	"2102":   "da lian shi",
+	"210202": "zhong shan qu",
+	"210203": "xi gang qu",
+	"210204": "sha he kou qu",
+	"210211": "gan jing zi qu",
+	"210212": "lv shun kou qu",
+	"210213": "jin zhou qu",
+	"210214": "pu lan dian qu",
+	"210224": "chang hai xian",
+	"210281": "wa fang dian shi",
+	"210283": "zhuang he shi",
+	"2103":   "an shan shi",
+	"210302": "tie dong qu",
+	"210303": "tie xi qu",
+	"210304": "li shan qu",
+	"210311": "qian shan qu",
+	"210321": "tai an xian",
+	"210323": "xiu yan man zu zi zhi xian",
+	"210381": "hai cheng shi",
+	"2104":   "fu shun shi",
+	"210402": "xin fu qu",
+	"210403": "dong zhou qu",
+	"210404": "wang hua qu",
+	"210411": "shun cheng qu",
+	"210421": "fu shun xian",
+	"210422": "xin bin man zu zi zhi xian",
+	"210423": "qing yuan man zu zi zhi xian",
+	"2105":   "ben xi shi",
+	"210502": "ping shan qu",
+	"210503": "xi hu qu",
+	"210504": "ming shan qu",
+	"210505": "nan fen qu",
+	"210521": "ben xi man zu zi zhi xian",
+	"210522": "huan ren man zu zi zhi xian",
+	"2106":   "dan dong shi",
+	"210602": "yuan bao qu",
+	"210603": "zhen xing qu",
+	"210604": "zhen an qu",
+	"210624": "kuan dian man zu zi zhi xian",
+	"210681": "dong gang shi",
+	"210682": "feng cheng shi",
+	"2107":   "jin zhou shi",
+	"210702": "gu ta qu",
+	"210703": "ling he qu",
+	"210711": "tai he qu",
+	"210726": "hei shan xian",
+	"210727": "yi xian",
+	"210781": "ling hai shi",
+	"210782": "bei zhen shi",
+	"2108":   "ying kou shi",
+	"210802": "zhan qian qu",
+	"210803": "xi shi qu",
+	"210804": "ba yu quan qu",
+	"210811": "lao bian qu",
+	"210881": "gai zhou shi",
+	"210882": "da shi qiao shi",
+	"2109":   "fu xin shi",
+	"210902": "hai zhou qu",
+	"210903": "xin qiu qu",
+	"210904": "tai ping qu",
+	"210905": "qing he men qu",
+	"210911": "xi he qu",
+	"210921": "fu xin meng gu zu zi zhi xian",
+	"210922": "zhang wu xian",
+	"2110":   "liao yang shi",
+	"211002": "bai ta qu",
+	"211003": "wen sheng qu",
+	"211004": "hong wei qu",
+	"211005": "gong chang ling qu",
+	"211011": "tai zi he qu",
+	"211021": "liao yang xian",
+	"211081": "deng ta shi",
+	"2111":   "pan jin shi",
+	"211102": "shuang tai zi qu",
+	"211103": "xing long tai qu",
+	"211104": "da wa qu",
+	"211122": "pan shan xian",
+	"2112":   "tie ling shi",
+	"211202": "yin zhou qu",
+	"211204": "qing he qu",
+	"211221": "tie ling xian",
+	"211223": "xi feng xian",
+	"211224": "chang tu xian",
+	"211281": "diao bing shan shi",
+	"211282": "kai yuan shi",
+	"2113":   "chao yang shi",
+	"211302": "shuang ta qu",
+	"211303": "long cheng qu",
+	"211321": "chao yang xian",
+	"211322": "jian ping xian",
+	"211324": "ka la qin zuo yi meng gu zu zi zhi xian",
+	"211381": "bei piao shi",
+	"211382": "ling yuan shi",
+	"2114":   "hu lu dao shi",
+	"211402": "lian shan qu",
+	"211403": "long gang qu",
+	"211404": "nan piao qu",
+	"211421": "sui zhong xian",
+	"211422": "jian chang xian",
+	"211481": "xing cheng shi",
+	"22":     "ji lin sheng",
+	"2201":   "chang chun shi",
+	"220102": "nan guan qu",
+	"220103": "kuan cheng qu",
+	"220104": "chao yang qu",
+	"220105": "er dao qu",
+	"220106": "lv yuan qu",
+	"220112": "shuang yang qu",
+	"220113": "jiu tai qu",
+	"220122": "nong an xian",
+	"220171": "chang chun jing ji ji shu kai fa qu",
+	"220172": "chang chun jing yue gao xin ji shu chan ye kai fa qu",
+	"220173": "chang chun gao xin ji shu chan ye kai fa qu",
+	"220174": "chang chun qi che jing ji ji shu kai fa qu",
+	"220182": "yu shu shi",
+	"220183": "de hui shi",
+	"220184": "gong zhu ling shi",
+	"2202":   "ji lin shi",
+	"220202": "chang yi qu",
+	"220203": "long tan qu",
+	"220204": "chuan ying qu",
+	"220211": "feng man qu",
+	"220221": "yong ji xian",
+	"220271": "ji lin jing ji kai fa qu",
+	"220272": "ji lin gao xin ji shu chan ye kai fa qu",
+	"220273": "ji lin zhong guo xin jia po shi pin qu",
+	"220281": "jiao he shi",
+	"220282": "hua dian shi",
+	"220283": "shu lan shi",
+	"220284": "pan shi shi",
+	"2203":   "si ping shi",
+	"220302": "tie xi qu",
+	"220303": "tie dong qu",
+	"220322": "li shu xian",
+	"220323": "yi tong man zu zi zhi xian",
+	"220382": "shuang liao shi",
+	"2204":   "liao yuan shi",
+	"220402": "long shan qu",
+	"220403": "xi an qu",
+	"220421": "dong feng xian",
+	"220422": "dong liao xian",
+	"2205":   "tong hua shi",
+	"220502": "dong chang qu",
+	"220503": "er dao jiang qu",
+	"220521": "tong hua xian",
+	"220523": "hui nan xian",
+	"220524": "liu he xian",
+	"220581": "mei he kou shi",
+	"220582": "ji an shi",
+	"2206":   "bai shan shi",
+	"220602": "hun jiang qu",
+	"220605": "jiang yuan qu",
+	"220621": "fu song xian",
+	"220622": "jing yu xian",
+	"220623": "chang bai chao xian zu zi zhi xian",
+	"220681": "lin jiang shi",
+	"2207":   "song yuan shi",
+	"220702": "ning jiang qu",
+	"220721": "qian guo er luo si meng gu zu zi zhi xian",
+	"220722": "chang ling xian",
+	"220723": "qian an xian",
+	"220771": "ji lin song yuan jing ji kai fa qu",
+	"220781": "fu yu shi",
+	"2208":   "bai cheng shi",
+	"220802": "tao bei qu",
+	"220821": "zhen lai xian",
+	"220822": "tong yu xian",
+	"220871": "ji lin bai cheng jing ji kai fa qu",
+	"220881": "tao nan shi",
+	"220882": "da an shi",
+	"2224":   "yan bian chao xian zu zi zhi zhou",
+	"222401": "yan ji shi",
+	"222402": "tu men shi",
+	"222403": "dun hua shi",
+	"222404": "hun chun shi",
+	"222405": "long jing shi",
+	"222406": "he long shi",
+	"222424": "wang qing xian",
+	"222426": "an tu xian",
+	"23":     "hei long jiang sheng",
+	"2301":   "ha er bin shi",
+	"230102": "dao li qu",
+	"230103": "nan gang qu",
+	"230104": "dao wai qu",
+	"230108": "ping fang qu",
+	"230109": "song bei qu",
+	"230110": "xiang fang qu",
+	"230111": "hu lan qu",
+	"230112": "a cheng qu",
+	"230113": "shuang cheng qu",
+	"230123": "yi lan xian",
+	"230124": "fang zheng xian",
+	"230125": "bin xian",
+	"230126": "ba yan xian",
+	"230127": "mu lan xian",
+	"230128": "tong he xian",
+	"230129": "yan shou xian",
+	"230183": "shang zhi shi",
+	"230184": "wu chang shi",
+	"2302":   "qi qi ha er shi",
+	"230202": "long sha qu",
+	"230203": "jian hua qu",
+	"230204": "tie feng qu",
+	"230205": "ang ang xi qu",
+	"230206": "fu la er ji qu",
+	"230207": "nian zi shan qu",
+	"230208": "mei li si da wo er zu qu",
+	"230221": "long jiang xian",
+	"230223": "yi an xian",
+	"230224": "tai lai xian",
+	"230225": "gan nan xian",
+	"230227": "fu yu xian",
+	"230229": "ke shan xian",
+	"230230": "ke dong xian",
+	"230231": "bai quan xian",
+	"230281": "ne he shi",
+	"2303":   "ji xi shi",
+	"230302": "ji guan qu",
+	"230303": "heng shan qu",
+	"230304": "di dao qu",
+	"230305": "li shu qu",
+	"230306": "cheng zi he qu",
+	"230307": "ma shan qu",
+	"230321": "ji dong xian",
+	"230381": "hu lin shi",
+	"230382": "mi shan shi",
+	"2304":   "he gang shi",
+	"230402": "xiang yang qu",
+	"230403": "gong nong qu",
+	"230404": "nan shan qu",
+	"230405": "xing an qu",
+	"230406": "dong shan qu",
+	"230407": "xing shan qu",
+	"230421": "luo bei xian",
+	"230422": "sui bin xian",
+	"2305":   "shuang ya shan shi",
+	"230502": "jian shan qu",
+	"230503": "ling dong qu",
+	"230505": "si fang tai qu",
+	"230506": "bao shan qu",
+	"230521": "ji xian xian",
+	"230522": "you yi xian",
+	"230523": "bao qing xian",
+	"230524": "rao he xian",
+	"2306":   "da qing shi",
+	"230602": "sa er tu qu",
+	"230603": "long feng qu",
+	"230604": "rang hu lu qu",
+	"230605": "hong gang qu",
+	"230606": "da tong qu",
+	"230621": "zhao zhou xian",
+	"230622": "zhao yuan xian",
+	"230623": "lin dian xian",
+	"230624": "du er bo te meng gu zu zi zhi xian",
+	"230671": "da qing gao xin ji shu chan ye kai fa qu",
+	"2307":   "yi chun shi",
+	"230717": "yi mei qu",
+	"230718": "wu cui qu",
+	"230719": "you hao qu",
+	"230722": "jia yin xian",
+	"230723": "tang wang xian",
+	"230724": "feng lin xian",
+	"230725": "da qing shan xian",
+	"230726": "nan cha xian",
+	"230751": "jin lin qu",
+	"230781": "tie li shi",
+	"2308":   "jia mu si shi",
+	"230803": "xiang yang qu",
+	"230804": "qian jin qu",
+	"230805": "dong feng qu",
+	"230811": "jiao qu",
+	"230822": "hua nan xian",
+	"230826": "hua chuan xian",
+	"230828": "tang yuan xian",
+	"230881": "tong jiang shi",
+	"230882": "fu jin shi",
+	"230883": "fu yuan shi",
+	"2309":   "qi tai he shi",
+	"230902": "xin xing qu",
+	"230903": "tao shan qu",
+	"230904": "qie zi he qu",
+	"230921": "bo li xian",
+	"2310":   "mu dan jiang shi",
+	"231002": "dong an qu",
+	"231003": "yang ming qu",
+	"231004": "ai min qu",
+	"231005": "xi an qu",
+	"231025": "lin kou xian",
+	"231081": "sui fen he shi",
+	"231083": "hai lin shi",
+	"231084": "ning an shi",
+	"231085": "mu ling shi",
+	"231086": "dong ning shi",
+	"2311":   "hei he shi",
+	"231102": "ai hui qu",
+	"231123": "xun ke xian",
+	"231124": "sun wu xian",
+	"231181": "bei an shi",
+	"231182": "wu da lian chi shi",
+	"231183": "nen jiang shi",
+	"2312":   "sui hua shi",
+	"231202": "bei lin qu",
+	"231221": "wang kui xian",
+	"231222": "lan xi xian",
+	"231223": "qing gang xian",
+	"231224": "qing an xian",
+	"231225": "ming shui xian",
+	"231226": "sui leng xian",
+	"231281": "an da shi",
+	"231282": "zhao dong shi",
+	"231283": "hai lun shi",
+	"2327":   "da xing an ling di qu",
+	"232701": "mo he shi",
+	"232721": "hu ma xian",
+	"232722": "ta he xian",
+	"232761": "jia ge da qi qu",
+	"232762": "song ling qu",
+	"232763": "xin lin qu",
+	"232764": "hu zhong qu",
+	"31":     "shang hai shi",
+	"3101":   "shi xia qu",
+	"310101": "huang pu qu",
+	"310104": "xu hui qu",
+	"310105": "chang ning qu",
+	"310106": "jing an qu",
+	"310107": "pu tuo qu",
+	"310109": "hong kou qu",
+	"310110": "yang pu qu",
+	"310112": "min hang qu",
+	"310113": "bao shan qu",
+	"310114": "jia ding qu",
+	"310115": "pu dong xin qu",
+	"310116": "jin shan qu",
+	"310117": "song jiang qu",
+	"310118": "qing pu qu",
+	"310120": "feng xian qu",
+	"310151": "chong ming qu",
+	"32":     "jiang su sheng",
+	"3201":   "nan jing shi",
+	"320102": "xuan wu qu",
+	"320104": "qin huai qu",
+	"320105": "jian ye qu",
+	"320106": "gu lou qu",
+	"320111": "pu kou qu",
+	"320113": "qi xia qu",
+	"320114": "yu hua tai qu",
+	"320115": "jiang ning qu",
+	"320116": "lu he qu",
+	"320117": "li shui qu",
+	"320118": "gao chun qu",
+	"3202":   "wu xi shi",
+	"320205": "xi shan qu",
+	"320206": "hui shan qu",
+	"320211": "bin hu qu",
+	"320213": "liang xi qu",
+	"320214": "xin wu qu",
+	"320281": "jiang yin shi",
+	"320282": "yi xing shi",
+	"3203":   "xu zhou shi",
+	"320302": "gu lou qu",
+	"320303": "yun long qu",
+	"320305": "jia wang qu",
+	"320311": "quan shan qu",
+	"320312": "tong shan qu",
+	"320321": "feng xian",
+	"320322": "pei xian",
+	"320324": "sui ning xian",
+	"320371": "xu zhou jing ji ji shu kai fa qu",
+	"320381": "xin yi shi",
+	"320382": "pi zhou shi",
+	"3204":   "chang zhou shi",
+	"320402": "tian ning qu",
+	"320404": "zhong lou qu",
+	"320411": "xin bei qu",
+	"320412": "wu jin qu",
+	"320413": "jin tan qu",
+	"320481": "li yang shi",
+	"3205":   "su zhou shi",
+	"320505": "hu qiu qu",
+	"320506": "wu zhong qu",
+	"320507": "xiang cheng qu",
+	"320508": "gu su qu",
+	"320509": "wu jiang qu",
+	"320576": "su zhou gong ye yuan qu",
+	"320581": "chang shu shi",
+	"320582": "zhang jia gang shi",
+	"320583": "kun shan shi",
+	"320585": "tai cang shi",
+	"3206":   "nan tong shi",
+	"320612": "tong zhou qu",
+	"320613": "chong chuan qu",
+	"320614": "hai men qu",
+	"320623": "ru dong xian",
+	"320671": "nan tong jing ji ji shu kai fa qu",
+	"320681": "qi dong shi",
+	"320682": "ru gao shi",
+	"320685": "hai an shi",
+	"3207":   "lian yun gang shi",
+	"320703": "lian yun qu",
+	"320706": "hai zhou qu",
+	"320707": "gan yu qu",
+	"320722": "dong hai xian",
+	"320723": "guan yun xian",
+	"320724": "guan nan xian",
+	"320771": "lian yun gang jing ji ji shu kai fa qu",
+	"3208":   "huai an shi",
+	"320803": "huai an qu",
+	"320804": "huai yin qu",
+	"320812": "qing jiang pu qu",
+	"320813": "hong ze qu",
+	"320826": "lian shui xian",
+	"320830": "xu yi xian",
+	"320831": "jin hu xian",
+	"320871": "huai an jing ji ji shu kai fa qu",
+	"3209":   "yan cheng shi",
+	"320902": "ting hu qu",
+	"320903": "yan du qu",
+	"320904": "da feng qu",
+	"320921": "xiang shui xian",
+	"320922": "bin hai xian",
+	"320923": "fu ning xian",
+	"320924": "she yang xian",
+	"320925": "jian hu xian",
+	"320971": "yan cheng jing ji ji shu kai fa qu",
+	"320981": "dong tai shi",
+	"3210":   "yang zhou shi",
+	"321002": "guang ling qu",
+	"321003": "han jiang qu",
+	"321012": "jiang du qu",
+	"321023": "bao ying xian",
+	"321071": "yang zhou jing ji ji shu kai fa qu",
+	"321081": "yi zheng shi",
+	"321084": "gao you shi",
+	"3211":   "zhen jiang shi",
+	"321102": "jing kou qu",
+	"321111": "run zhou qu",
+	"321112": "dan tu qu",
+	"321171": "zhen jiang xin qu",
+	"321181": "dan yang shi",
+	"321182": "yang zhong shi",
+	"321183": "ju rong shi",
+	"3212":   "tai zhou shi",
+	"321202": "hai ling qu",
+	"321203": "gao gang qu",
+	"321204": "jiang yan qu",
+	"321281": "xing hua shi",
+	"321282": "jing jiang shi",
+	"321283": "tai xing shi",
+	"3213":   "su qian shi",
+	"321302": "su cheng qu",
+	"321311": "su yu qu",
+	"321322": "shu yang xian",
+	"321323": "si yang xian",
+	"321324": "si hong xian",
+	"321371": "su qian jing ji ji shu kai fa qu",
+	"33":     "zhe jiang sheng",
+	"3301":   "hang zhou shi",
+	"330102": "shang cheng qu",
+	"330105": "gong shu qu",
+	"330106": "xi hu qu",
+	"330108": "bin jiang qu",
+	"330109": "xiao shan qu",
+	"330110": "yu hang qu",
+	"330111": "fu yang qu",
+	"330112": "lin an qu",
+	"330113": "lin ping qu",
+	"330114": "qian tang qu",
+	"330122": "tong lu xian",
+	"330127": "chun an xian",
+	"330182": "jian de shi",
+	"3302":   "ning bo shi",
+	"330203": "hai shu qu",
+	"330205": "jiang bei qu",
+	"330206": "bei lun qu",
+	"330211": "zhen hai qu",
+	"330212": "yin zhou qu",
+	"330213": "feng hua qu",
+	"330225": "xiang shan xian",
+	"330226": "ning hai xian",
+	"330281": "yu yao shi",
+	"330282": "ci xi shi",
+	"3303":   "wen zhou shi",
+	"330302": "lu cheng qu",
+	"330303": "long wan qu",
+	"330304": "ou hai qu",
+	"330305": "dong tou qu",
+	"330324": "yong jia xian",
+	"330326": "ping yang xian",
+	"330327": "cang nan xian",
+	"330328": "wen cheng xian",
+	"330329": "tai shun xian",
+	"330381": "rui an shi",
+	"330382": "yue qing shi",
+	"330383": "long gang shi",
+	"3304":   "jia xing shi",
+	"330402": "nan hu qu",
+	"330411": "xiu zhou qu",
+	"330421": "jia shan xian",
+	"330424": "hai yan xian",
+	"330481": "hai ning shi",
+	"330482": "ping hu shi",
+	"330483": "tong xiang shi",
+	"3305":   "hu zhou shi",
+	"330502": "wu xing qu",
+	"330503": "nan xun qu",
+	"330521": "de qing xian",
+	"330522": "chang xing xian",
+	"330523": "an ji xian",
+	"3306":   "shao xing shi",
+	"330602": "yue cheng qu",
+	"330603": "ke qiao qu",
+	"330604": "shang yu qu",
+	"330624": "xin chang xian",
+	"330681": "zhu ji shi",
+	"330683": "sheng zhou shi",
+	"3307":   "jin hua shi",
+	"330702": "wu cheng qu",
+	"330703": "jin dong qu",
+	"330723": "wu yi xian",
+	"330726": "pu jiang xian",
+	"330727": "pan an xian",
+	"330781": "lan xi shi",
+	"330782": "yi wu shi",
+	"330783": "dong yang shi",
+	"330784": "yong kang shi",
+	"3308":   "qu zhou shi",
+	"330802": "ke cheng qu",
+	"330803": "qu jiang qu",
+	"330822": "chang shan xian",
+	"330824": "kai hua xian",
+	"330825": "long you xian",
+	"330881": "jiang shan shi",
+	"3309":   "zhou shan shi",
+	"330902": "ding hai qu",
+	"330903": "pu tuo qu",
+	"330921": "dai shan xian",
+	"330922": "sheng si xian",
+	"3310":   "tai zhou shi",
+	"331002": "jiao jiang qu",
+	"331003": "huang yan qu",
+	"331004": "lu qiao qu",
+	"331022": "san men xian",
+	"331023": "tian tai xian",
+	"331024": "xian ju xian",
+	"331081": "wen ling shi",
+	"331082": "lin hai shi",
+	"331083": "yu huan shi",
+	"3311":   "li shui shi",
+	"331102": "lian du qu",
+	"331121": "qing tian xian",
+	"331122": "jin yun xian",
+	"331123": "sui chang xian",
+	"331124": "song yang xian",
+	"331125": "yun he xian",
+	"331126": "qing yuan xian",
+	"331127": "jing ning she zu zi zhi xian",
+	"331181": "long quan shi",
+	"34":     "an hui sheng",
+	"3401":   "he fei shi",
+	"340102": "yao hai qu",
+	"340103": "lu yang qu",
+	"340104": "shu shan qu",
+	"340111": "bao he qu",
+	"340121": "chang feng xian",
+	"340122": "fei dong xian",
+	"340123": "fei xi xian",
+	"340124": "lu jiang xian",
+	"340176": "he fei gao xin ji shu chan ye kai fa qu",
+	"340177": "he fei jing ji ji shu kai fa qu",
+	"340178": "he fei xin zhan gao xin ji shu chan ye kai fa qu",
+	"340181": "chao hu shi",
+	"3402":   "wu hu shi",
+	"340202": "jing hu qu",
+	"340207": "jiu jiang qu",
+	"340209": "yi jiang qu",
+	"340210": "wan zhi qu",
+	"340212": "fan chang qu",
+	"340223": "nan ling xian",
+	"340271": "wu hu jing ji ji shu kai fa qu",
+	"340272": "an hui wu hu san shan jing ji kai fa qu",
+	"340281": "wu wei shi",
+	"3403":   "beng bu shi",
+	"340302": "long zi hu qu",
+	"340303": "beng shan qu",
+	"340304": "yu hui qu",
+	"340311": "huai shang qu",
+	"340321": "huai yuan xian",
+	"340322": "wu he xian",
+	"340323": "gu zhen xian",
+	"340371": "beng bu shi gao xin ji shu kai fa qu",
+	"340372": "beng bu shi jing ji kai fa qu",
+	"3404":   "huai nan shi",
+	"340402": "da tong qu",
+	"340403": "tian jia an qu",
+	"340404": "xie jia ji qu",
+	"340405": "ba gong shan qu",
+	"340406": "pan ji qu",
+	"340421": "feng tai xian",
+	"340422": "shou xian",
+	"3405":   "ma an shan shi",
+	"340503": "hua shan qu",
+	"340504": "yu shan qu",
+	"340506": "bo wang qu",
+	"340521": "dang tu xian",
+	"340522": "han shan xian",
+	"340523": "he xian",
+	"3406":   "huai bei shi",
+	"340602": "du ji qu",
+	"340603": "xiang shan qu",
+	"340604": "lie shan qu",
+	"340621": "sui xi xian",
+	"3407":   "tong ling shi",
+	"340705": "tong guan qu",
+	"340706": "yi an qu",
+	"340711": "jiao qu",
+	"340722": "zong yang xian",
+	"3408":   "an qing shi",
+	"340802": "ying jiang qu",
+	"340803": "da guan qu",
+	"340811": "yi xiu qu",
+	"340822": "huai ning xian",
+	"340825": "tai hu xian",
+	"340826": "su song xian",
+	"340827": "wang jiang xian",
+	"340828": "yue xi xian",
+	"340871": "an hui an qing jing ji kai fa qu",
+	"340881": "tong cheng shi",
+	"340882": "qian shan shi",
+	"3410":   "huang shan shi",
+	"341002": "tun xi qu",
+	"341003": "huang shan qu",
+	"341004": "hui zhou qu",
+	"341021": "she xian",
+	"341022": "xiu ning xian",
+	"341023": "yi xian",
+	"341024": "qi men xian",
+	"3411":   "chu zhou shi",
+	"341102": "lang ya qu",
+	"341103": "nan qiao qu",
+	"341122": "lai an xian",
+	"341124": "quan jiao xian",
+	"341125": "ding yuan xian",
+	"341126": "feng yang xian",
+	"341171": "zhong xin su chu gao xin ji shu chan ye kai fa qu",
+	"341172": "chu zhou jing ji ji shu kai fa qu",
+	"341181": "tian chang shi",
+	"341182": "ming guang shi",
+	"3412":   "fu yang shi",
+	"341202": "ying zhou qu",
+	"341203": "ying dong qu",
+	"341204": "ying quan qu",
+	"341221": "lin quan xian",
+	"341222": "tai he xian",
+	"341225": "fu nan xian",
+	"341226": "ying shang xian",
+	"341271": "fu yang he fei xian dai chan ye yuan qu",
+	"341272": "fu yang jing ji ji shu kai fa qu",
+	"341282": "jie shou shi",
+	"3413":   "su zhou shi",
+	"341302": "yong qiao qu",
+	"341321": "dang shan xian",
+	"341322": "xiao xian",
+	"341323": "ling bi xian",
+	"341324": "si xian",
+	"341371": "su zhou ma an shan xian dai chan ye yuan qu",
+	"341372": "su zhou jing ji ji shu kai fa qu",
+	"3415":   "lu an shi",
+	"341502": "jin an qu",
+	"341503": "yu an qu",
+	"341504": "ye ji qu",
+	"341522": "huo qiu xian",
+	"341523": "shu cheng xian",
+	"341524": "jin zhai xian",
+	"341525": "huo shan xian",
+	"3416":   "bo zhou shi",
+	"341602": "qiao cheng qu",
+	"341621": "guo yang xian",
+	"341622": "meng cheng xian",
+	"341623": "li xin xian",
+	"3417":   "chi zhou shi",
+	"341702": "gui chi qu",
+	"341721": "dong zhi xian",
+	"341722": "shi tai xian",
+	"341723": "qing yang xian",
+	"3418":   "xuan cheng shi",
+	"341802": "xuan zhou qu",
+	"341821": "lang xi xian",
+	"341823": "jing xian",
+	"341824": "ji xi xian",
+	"341825": "jing de xian",
+	"341871": "xuan cheng shi jing ji kai fa qu",
+	"341881": "ning guo shi",
+	"341882": "guang de shi",
+	"35":     "fu jian sheng",
+	"3501":   "fu zhou shi",
+	"350102": "gu lou qu",
+	"350103": "tai jiang qu",
+	"350104": "cang shan qu",
+	"350105": "ma wei qu",
+	"350111": "jin an qu",
+	"350112": "chang le qu",
+	"350121": "min hou xian",
+	"350122": "lian jiang xian",
+	"350123": "luo yuan xian",
+	"350124": "min qing xian",
+	"350125": "yong tai xian",
+	"350128": "ping tan xian",
+	"350181": "fu qing shi",
+	"3502":   "xia men shi",
+	"350203": "si ming qu",
+	"350205": "hai cang qu",
+	"350206": "hu li qu",
+	"350211": "ji mei qu",
+	"350212": "tong an qu",
+	"350213": "xiang an qu",
+	"3503":   "pu tian shi",
+	"350302": "cheng xiang qu",
+	"350303": "han jiang qu",
+	"350304": "li cheng qu",
+	"350305": "xiu yu qu",
+	"350322": "xian you xian",
+	"3504":   "san ming shi",
+	"350404": "san yuan qu",
+	"350405": "sha xian qu",
+	"350421": "ming xi xian",
+	"350423": "qing liu xian",
+	"350424": "ning hua xian",
+	"350425": "da tian xian",
+	"350426": "you xi xian",
+	"350428": "jiang le xian",
+	"350429": "tai ning xian",
+	"350430": "jian ning xian",
+	"350481": "yong an shi",
+	"3505":   "quan zhou shi",
+	"350502": "li cheng qu",
+	"350503": "feng ze qu",
+	"350504": "luo jiang qu",
+	"350505": "quan gang qu",
+	"350521": "hui an xian",
+	"350524": "an xi xian",
+	"350525": "yong chun xian",
+	"350526": "de hua xian",
+	"350527": "jin men xian",
+	"350581": "shi shi shi",
+	"350582": "jin jiang shi",
+	"350583": "nan an shi",
+	"3506":   "zhang zhou shi",
+	"350602": "xiang cheng qu",
+	"350603": "long wen qu",
+	"350604": "long hai qu",
+	"350605": "chang tai qu",
+	"350622": "yun xiao xian",
+	"350623": "zhang pu xian",
+	"350624": "zhao an xian",
+	"350626": "dong shan xian",
+	"350627": "nan jing xian",
+	"350628": "ping he xian",
+	"350629": "hua an xian",
+	"3507":   "nan ping shi",
+	"350702": "yan ping qu",
+	"350703": "jian yang qu",
+	"350721": "shun chang xian",
+	"350722": "pu cheng xian",
+	"350723": "guang ze xian",
+	"350724": "song xi xian",
+	"350725": "zheng he xian",
+	"350781": "shao wu shi",
+	"350782": "wu yi shan shi",
+	"350783": "jian ou shi",
+	"3508":   "long yan shi",
+	"350802": "xin luo qu",
+	"350803": "yong ding qu",
+	"350821": "chang ting xian",
+	"350823": "shang hang xian",
+	"350824": "wu ping xian",
+	"350825": "lian cheng xian",
+	"350881": "zhang ping shi",
+	"3509":   "ning de shi",
+	"350902": "jiao cheng qu",
+	"350921": "xia pu xian",
+	"350922": "gu tian xian",
+	"350923": "ping nan xian",
+	"350924": "shou ning xian",
+	"350925": "zhou ning xian",
+	"350926": "zhe rong xian",
+	"350981": "fu an shi",
+	"350982": "fu ding shi",
+	"36":     "jiang xi sheng",
+	"3601":   "nan chang shi",
+	"360102": "dong hu qu",
+	"360103": "xi hu qu",
+	"360104": "qing yun pu qu",
+	"360111": "qing shan hu qu",
+	"360112": "xin jian qu",
+	"360113": "hong gu tan qu",
+	"360121": "nan chang xian",
+	"360123": "an yi xian",
+	"360124": "jin xian xian",
+	"3602":   "jing de zhen shi",
+	"360202": "chang jiang qu",
+	"360203": "zhu shan qu",
+	"360222": "fu liang xian",
+	"360281": "le ping shi",
+	"3603":   "ping xiang shi",
+	"360302": "an yuan qu",
+	"360313": "xiang dong qu",
+	"360321": "lian hua xian",
+	"360322": "shang li xian",
+	"360323": "lu xi xian",
+	"3604":   "jiu jiang shi",
+	"360402": "lian xi qu",
+	"360403": "xun yang qu",
+	"360404": "chai sang qu",
+	"360423": "wu ning xian",
+	"360424": "xiu shui xian",
+	"360425": "yong xiu xian",
+	"360426": "de an xian",
+	"360428": "du chang xian",
+	"360429": "hu kou xian",
+	"360430": "peng ze xian",
+	"360481": "rui chang shi",
+	"360482": "gong qing cheng shi",
+	"360483": "lu shan shi",
+	"3605":   "xin yu shi",
+	"360502": "yu shui qu",
+	"360521": "fen yi xian",
+	"3606":   "ying tan shi",
+	"360602": "yue hu qu",
+	"360603": "yu jiang qu",
+	"360681": "gui xi shi",
+	"3607":   "gan zhou shi",
+	"360702": "zhang gong qu",
+	"360703": "nan kang qu",
+	"360704": "gan xian qu",
+	"360722": "xin feng xian",
+	"360723": "da yu xian",
+	"360724": "shang you xian",
+	"360725": "chong yi xian",
+	"360726": "an yuan xian",
+	"360728": "ding nan xian",
+	"360729": "quan nan xian",
+	"360730": "ning du xian",
+	"360731": "yu du xian",
+	"360732": "xing guo xian",
+	"360733": "hui chang xian",
+	"360734": "xun wu xian",
+	"360735": "shi cheng xian",
+	"360781": "rui jin shi",
+	"360783": "long nan shi",
+	"3608":   "ji an shi",
+	"360802": "ji zhou qu",
+	"360803": "qing yuan qu",
+	"360821": "ji an xian",
+	"360822": "ji shui xian",
+	"360823": "xia jiang xian",
+	"360824": "xin gan xian",
+	"360825": "yong feng xian",
+	"360826": "tai he xian",
+	"360827": "sui chuan xian",
+	"360828": "wan an xian",
+	"360829": "an fu xian",
+	"360830": "yong xin xian",
+	"360881": "jing gang shan shi",
+	"3609":   "yi chun shi",
+	"360902": "yuan zhou qu",
+	"360921": "feng xin xian",
+	"360922": "wan zai xian",
+	"360923": "shang gao xian",
+	"360924": "yi feng xian",
+	"360925": "jing an xian",
+	"360926": "tong gu xian",
+	"360981": "feng cheng shi",
+	"360982": "zhang shu shi",
+	"360983": "gao an shi",
+	"3610":   "fu zhou shi",
+	"361002": "lin chuan qu",
+	"361003": "dong xiang qu",
+	"361021": "nan cheng xian",
+	"361022": "li chuan xian",
+	"361023": "nan feng xian",
+	"361024": "chong ren xian",
+	"361025": "le an xian",
+	"361026": "yi huang xian",
+	"361027": "jin xi xian",
+	"361028": "zi xi xian",
+	"361030": "guang chang xian",
+	"3611":   "shang rao shi",
+	"361102": "xin zhou qu",
+	"361103": "guang feng qu",
+	"361104": "guang xin qu",
+	"361123": "yu shan xian",
+	"361124": "yan shan xian",
+	"361125": "heng feng xian",
+	"361126": "yi yang xian",
+	"361127": "yu gan xian",
+	"361128": "po yang xian",
+	"361129": "wan nian xian",
+	"361130": "wu yuan xian",
+	"361181": "de xing shi",
+	"37":     "shan dong sheng",
+	"3701":   "ji nan shi",
+	"370102": "li xia qu",
+	"370103": "shi zhong qu",
+	"370104": "huai yin qu",
+	"370105": "tian qiao qu",
+	"370112": "li cheng qu",
+	"370113": "chang qing qu",
+	"370114": "zhang qiu qu",
+	"370115": "ji yang qu",
+	"370116": "lai wu qu",
+	"370117": "gang cheng qu",
+	"370124": "ping yin xian",
+	"370126": "shang he xian",
+	"370176": "ji nan gao xin ji shu chan ye kai fa qu",
+	"3702":   "qing dao shi",
+	"370202": "shi nan qu",
+	"370203": "shi bei qu",
+	"370211": "huang dao qu",
+	"370212": "lao shan qu",
+	"370213": "li cang qu",
+	"370214": "cheng yang qu",
+	"370215": "ji mo qu",
+	"370281": "jiao zhou shi",
+	"370283": "ping du shi",
+	"370285": "lai xi shi",
+	"3703":   "zi bo shi",
+	"370302": "zi chuan qu",
+	"370303": "zhang dian qu",
+	"370304": "bo shan qu",
+	"370305": "lin zi qu",
+	"370306": "zhou cun qu",
+	"370321": "huan tai xian",
+	"370322": "gao qing xian",
+	"370323": "yi yuan xian",
+	"3704":   "zao zhuang shi",
+	"370402": "shi zhong qu",
+	"370403": "xue cheng qu",
+	"370404": "yi cheng qu",
+	"370405": "tai er zhuang qu",
+	"370406": "shan ting qu",
+	"370481": "teng zhou shi",
+	"3705":   "dong ying shi",
+	"370502": "dong ying qu",
+	"370503": "he kou qu",
+	"370505": "ken li qu",
+	"370522": "li jin xian",
+	"370523": "guang rao xian",
+	"370571": "dong ying jing ji ji shu kai fa qu",
+	"370572": "dong ying gang jing ji kai fa qu",
+	"3706":   "yan tai shi",
+	"370602": "zhi fu qu",
+	"370611": "fu shan qu",
+	"370612": "mu ping qu",
+	"370613": "lai shan qu",
+	"370614": "peng lai qu",
+	"370671": "yan tai gao xin ji shu chan ye kai fa qu",
+	"370676": "yan tai jing ji ji shu kai fa qu",
+	"370681": "long kou shi",
+	"370682": "lai yang shi",
+	"370683": "lai zhou shi",
+	"370685": "zhao yuan shi",
+	"370686": "qi xia shi",
+	"370687": "hai yang shi",
+	"3707":   "wei fang shi",
+	"370702": "wei cheng qu",
+	"370703": "han ting qu",
+	"370704": "fang zi qu",
+	"370705": "kui wen qu",
+	"370724": "lin qu xian",
+	"370725": "chang le xian",
+	"370772": "wei fang bin hai jing ji ji shu kai fa qu",
+	"370781": "qing zhou shi",
+	"370782": "zhu cheng shi",
+	"370783": "shou guang shi",
+	"370784": "an qiu shi",
+	"370785": "gao mi shi",
+	"370786": "chang yi shi",
+	"3708":   "ji ning shi",
+	"370811": "ren cheng qu",
+	"370812": "yan zhou qu",
+	"370826": "wei shan xian",
+	"370827": "yu tai xian",
+	"370828": "jin xiang xian",
+	"370829": "jia xiang xian",
+	"370830": "wen shang xian",
+	"370831": "si shui xian",
+	"370832": "liang shan xian",
+	"370871": "ji ning gao xin ji shu chan ye kai fa qu",
+	"370881": "qu fu shi",
+	"370883": "zou cheng shi",
+	"3709":   "tai an shi",
+	"370902": "tai shan qu",
+	"370911": "dai yue qu",
+	"370921": "ning yang xian",
+	"370923": "dong ping xian",
+	"370982": "xin tai shi",
+	"370983": "fei cheng shi",
+	"3710":   "wei hai shi",
+	"371002": "huan cui qu",
+	"371003": "wen deng qu",
+	"371071": "wei hai huo ju gao ji shu chan ye kai fa qu",
+	"371072": "wei hai jing ji ji shu kai fa qu",
+	"371073": "wei hai lin gang jing ji ji shu kai fa qu",
+	"371082": "rong cheng shi",
+	"371083": "ru shan shi",
+	"3711":   "ri zhao shi",
+	"371102": "dong gang qu",
+	"371103": "lan shan qu",
+	"371121": "wu lian xian",
+	"371122": "ju xian",
+	"371171": "ri zhao jing ji ji shu kai fa qu",
+	"3713":   "lin yi shi",
+	"371302": "lan shan qu",
+	"371311": "luo zhuang qu",
+	"371312": "he dong qu",
+	"371321": "yi nan xian",
+	"371322": "tan cheng xian",
+	"371323": "yi shui xian",
+	"371324": "lan ling xian",
+	"371325": "fei xian",
+	"371326": "ping yi xian",
+	"371327": "ju nan xian",
+	"371328": "meng yin xian",
+	"371329": "lin shu xian",
+	"371371": "lin yi gao xin ji shu chan ye kai fa qu",
+	"3714":   "de zhou shi",
+	"371402": "de cheng qu",
+	"371403": "ling cheng qu",
+	"371422": "ning jin xian",
+	"371423": "qing yun xian",
+	"371424": "lin yi xian",
+	"371425": "qi he xian",
+	"371426": "ping yuan xian",
+	"371427": "xia jin xian",
+	"371428": "wu cheng xian",
+	"371471": "de zhou tian qu xin qu",
+	"371481": "le ling shi",
+	"371482": "yu cheng shi",
+	"3715":   "liao cheng shi",
+	"371502": "dong chang fu qu",
+	"371503": "chi ping qu",
+	"371521": "yang gu xian",
+	"371522": "shen xian",
+	"371524": "dong e xian",
+	"371525": "guan xian",
+	"371526": "gao tang xian",
+	"371581": "lin qing shi",
+	"3716":   "bin zhou shi",
+	"371602": "bin cheng qu",
+	"371603": "zhan hua qu",
+	"371621": "hui min xian",
+	"371622": "yang xin xian",
+	"371623": "wu di xian",
+	"371625": "bo xing xian",
+	"371681": "zou ping shi",
+	"3717":   "he ze shi",
+	"371702": "mu dan qu",
+	"371703": "ding tao qu",
+	"371721": "cao xian",
+	"371722": "shan xian",
+	"371723": "cheng wu xian",
+	"371724": "ju ye xian",
+	"371725": "yun cheng xian",
+	"371726": "juan cheng xian",
+	"371728": "dong ming xian",
+	"371771": "he ze jing ji ji shu kai fa qu",
+	"371772": "he ze gao xin ji shu kai fa qu",
+	"41":     "he nan sheng",
+	"4101":   "zheng zhou shi",
+	"410102": "zhong yuan qu",
+	"410103": "er qi qu",
+	"410104": "guan cheng hui zu qu",
+	"410105": "jin shui qu",
+	"410106": "shang jie qu",
+	"410108": "hui ji qu",
+	"410122": "zhong mu xian",
+	"410171": "zheng zhou jing ji ji shu kai fa qu",
+	"410172": "zheng zhou gao xin ji shu chan ye kai fa qu",
+	"410173": "zheng zhou hang kong gang jing ji zong he shi yan qu",
+	"410181": "gong yi shi",
+	"410182": "xing yang shi",
+	"410183": "xin mi shi",
+	"410184": "xin zheng shi",
+	"410185": "deng feng shi",
+	"4102":   "kai feng shi",
+	"410202": "long ting qu",
+	"410203": "shun he hui zu qu",
+	"410204": "gu lou qu",
+	"410205": "yu wang tai qu",
+	"410212": "xiang fu qu",
+	"410221": "qi xian",
+	"410222": "tong xu xian",
+	"410223": "wei shi xian",
+	"410225": "lan kao xian",
+	"4103":   "luo yang shi",
+	"410302": "lao cheng qu",
+	"410303": "xi gong qu",
+	"410304": "chan he hui zu qu",
+	"410305": "jian xi qu",
+	"410307": "yan shi qu",
+	"410308": "meng jin qu",
+	"410311": "luo long qu",
+	"410323": "xin an xian",
+	"410324": "luan chuan xian",
+	"410325": "song xian",
+	"410326": "ru yang xian",
+	"410327": "yi yang xian",
+	"410328": "luo ning xian",
+	"410329": "yi chuan xian",
+	"410371": "luo yang gao xin ji shu chan ye kai fa qu",
+	"4104":   "ping ding shan shi",
+	"410402": "xin hua qu",
+	"410403": "wei dong qu",
+	"410404": "shi long qu",
+	"410411": "zhan he qu",
+	"410421": "bao feng xian",
+	"410422": "ye xian",
+	"410423": "lu shan xian",
+	"410425": "jia xian",
+	"410471": "ping ding shan gao xin ji shu chan ye kai fa qu",
+	"410472": "ping ding shan shi cheng xiang yi ti hua shi fan qu",
+	"410481": "wu gang shi",
+	"410482": "ru zhou shi",
+	"4105":   "an yang shi",
+	"410502": "wen feng qu",
+	"410503": "bei guan qu",
+	"410505": "yin du qu",
+	"410506": "long an qu",
+	"410522": "an yang xian",
+	"410523": "tang yin xian",
+	"410526": "hua xian",
+	"410527": "nei huang xian",
+	"410571": "an yang gao xin ji shu chan ye kai fa qu",
+	"410581": "lin zhou shi",
+	"4106":   "he bi shi",
+	"410602": "he shan qu",
+	"410603": "shan cheng qu",
+	"410611": "qi bin qu",
+	"410621": "xun xian",
+	"410622": "qi xian",
+	"410671": "he bi jing ji ji shu kai fa qu",
+	"4107":   "xin xiang shi",
+	"410702": "hong qi qu",
+	"410703": "wei bin qu",
+	"410704": "feng quan qu",
+	"410711": "mu ye qu",
+	"410721": "xin xiang xian",
+	"410724": "huo jia xian",
+	"410725": "yuan yang xian",
+	"410726": "yan jin xian",
+	"410727": "feng qiu xian",
+	"410771": "xin xiang gao xin ji shu chan ye kai fa qu",
+	"410772": "xin xiang jing ji ji shu kai fa qu",
+	"410773": "xin xiang shi ping yuan cheng xiang yi ti hua shi fan qu",
+	"410781": "wei hui shi",
+	"410782": "hui xian shi",
+	"410783": "chang yuan shi",
+	"4108":   "jiao zuo shi",
+	"410802": "jie fang qu",
+	"410803": "zhong zhan qu",
+	"410804": "ma cun qu",
+	"410811": "shan yang qu",
+	"410821": "xiu wu xian",
+	"410822": "bo ai xian",
+	"410823": "wu zhi xian",
+	"410825": "wen xian",
+	"410871": "jiao zuo cheng xiang yi ti hua shi fan qu",
+	"410882": "qin yang shi",
+	"410883": "meng zhou shi",
+	"4109":   "pu yang shi",
+	"410902": "hua long qu",
+	"410922": "qing feng xian",
+	"410923": "nan le xian",
+	"410926": "fan xian",
+	"410927": "tai qian xian",
+	"410928": "pu yang xian",
+	"410971": "he nan pu yang gong ye yuan qu",
+	"410972": "pu yang jing ji ji shu kai fa qu",
+	"4110":   "xu chang shi",
+	"411002": "wei du qu",
+	"411003": "jian an qu",
+	"411024": "yan ling xian",
+	"411025": "xiang cheng xian",
+	"411071": "xu chang jing ji ji shu kai fa qu",
+	"411081": "yu zhou shi",
+	"411082": "chang ge shi",
+	"4111":   "luo he shi",
+	"411102": "yuan hui qu",
+	"411103": "yan cheng qu",
+	"411104": "shao ling qu",
+	"411121": "wu yang xian",
+	"411122": "lin ying xian",
+	"411171": "luo he jing ji ji shu kai fa qu",
+	"4112":   "san men xia shi",
+	"411202": "hu bin qu",
+	"411203": "shan zhou qu",
+	"411221": "mian chi xian",
+	"411224": "lu shi xian",
+	"411271": "he nan san men xia jing ji kai fa qu",
+	"411281": "yi ma shi",
+	"411282": "ling bao shi",
+	"4113":   "nan yang shi",
+	"411302": "wan cheng qu",
+	"411303": "wo long qu",
+	"411321": "nan zhao xian",
+	"411322": "fang cheng xian",
+	"411323": "xi xia xian",
+	"411324": "zhen ping xian",
+	"411325": "nei xiang xian",
+	"411326": "xi chuan xian",
+	"411327": "she qi xian",
+	"411328": "tang he xian",
+	"411329": "xin ye xian",
+	"411330": "tong bai xian",
+	"411371": "nan yang gao xin ji shu chan ye kai fa qu",
+	"411372": "nan yang shi cheng xiang yi ti hua shi fan qu",
+	"411381": "deng zhou shi",
+	"4114":   "shang qiu shi",
+	"411402": "liang yuan qu",
+	"411403": "sui yang qu",
+	"411421": "min quan xian",
+	"411422": "sui xian",
+	"411423": "ning ling xian",
+	"411424": "zhe cheng xian",
+	"411425": "yu cheng xian",
+	"411426": "xia yi xian",
+	"411471": "yu dong zong he wu liu chan ye ju ji qu",
+	"411472": "he nan shang qiu jing ji kai fa qu",
+	"411481": "yong cheng shi",
+	"4115":   "xin yang shi",
+	"411502": "shi he qu",
+	"411503": "ping qiao qu",
+	"411521": "luo shan xian",
+	"411522": "guang shan xian",
+	"411523": "xin xian",
+	"411524": "shang cheng xian",
+	"411525": "gu shi xian",
+	"411526": "huang chuan xian",
+	"411527": "huai bin xian",
+	"411528": "xi xian",
+	"411571": "xin yang gao xin ji shu chan ye kai fa qu",
+	"4116":   "zhou kou shi",
+	"411602": "chuan hui qu",
+	"411603": "huai yang qu",
+	"411621": "fu gou xian",
+	"411622": "xi hua xian",
+	"411623": "shang shui xian",
+	"411624": "shen qiu xian",
+	"411625": "dan cheng xian",
+	"411627": "tai kang xian",
+	"411628": "lu yi xian",
+	"411671": "zhou kou lin gang kai fa qu",
+	"411681": "xiang cheng shi",
+	"4117":   "zhu ma dian shi",
+	"411702": "yi cheng qu",
+	"411721": "xi ping xian",
+	"411722": "shang cai xian",
+	"411723": "ping yu xian",
+	"411724": "zheng yang xian",
+	"411725": "que shan xian",
+	"411726": "bi yang xian",
+	"411727": "ru nan xian",
+	"411728": "sui ping xian",
+	"411729": "xin cai xian",
+	"411771": "he nan zhu ma dian jing ji kai fa qu",
+	"4190":   "sheng zhi xia xian ji xing zheng qu hua",
+	"419001": "ji yuan shi",
+	"42":     "hu bei sheng",
+	"4201":   "wu han shi",
+	"420102": "jiang an qu",
+	"420103": "jiang han qu",
+	"420104": "qiao kou qu",
+	"420105": "han yang qu",
+	"420106": "wu chang qu",
+	"420107": "qing shan qu",
+	"420111": "hong shan qu",
+	"420112": "dong xi hu qu",
+	"420113": "han nan qu",
+	"420114": "cai dian qu",
+	"420115": "jiang xia qu",
+	"420116": "huang pi qu",
+	"420117": "xin zhou qu",
+	"4202":   "huang shi shi",
+	"420202": "huang shi gang qu",
+	"420203": "xi sai shan qu",
+	"420204": "xia lu qu",
+	"420205": "tie shan qu",
+	"420222": "yang xin xian",
+	"420281": "da ye shi",
+	"4203":   "shi yan shi",
+	"420302": "mao jian qu",
+	"420303": "zhang wan qu",
+	"420304": "yun yang qu",
+	"420322": "yun xi xian",
+	"420323": "zhu shan xian",
+	"420324": "zhu xi xian",
+	"420325": "fang xian",
+	"420381": "dan jiang kou shi",
+	"4205":   "yi chang shi",
+	"420502": "xi ling qu",
+	"420503": "wu jia gang qu",
+	"420504": "dian jun qu",
+	"420505": "xiao ting qu",
+	"420506": "yi ling qu",
+	"420525": "yuan an xian",
+	"420526": "xing shan xian",
+	"420527": "zi gui xian",
+	"420528": "chang yang tu jia zu zi zhi xian",
+	"420529": "wu feng tu jia zu zi zhi xian",
+	"420581": "yi du shi",
+	"420582": "dang yang shi",
+	"420583": "zhi jiang shi",
+	"4206":   "xiang yang shi",
+	"420602": "xiang cheng qu",
+	"420606": "fan cheng qu",
+	"420607": "xiang zhou qu",
+	"420624": "nan zhang xian",
+	"420625": "gu cheng xian",
+	"420626": "bao kang xian",
+	"420682": "lao he kou shi",
+	"420683": "zao yang shi",
+	"420684": "yi cheng shi",
+	"4207":   "e zhou shi",
+	"420702": "liang zi hu qu",
+	"420703": "hua rong qu",
+	"420704": "e cheng qu",
+	"4208":   "jing men shi",
+	"420802": "dong bao qu",
+	"420804": "duo dao qu",
+	"420822": "sha yang xian",
+	"420881": "zhong xiang shi",
+	"420882": "jing shan shi",
+	"4209":   "xiao gan shi",
+	"420902": "xiao nan qu",
+	"420921": "xiao chang xian",
+	"420922": "da wu xian",
+	"420923": "yun meng xian",
+	"420981": "ying cheng shi",
+	"420982": "an lu shi",
+	"420984": "han chuan shi",
+	"4210":   "jing zhou shi",
+	"421002": "sha shi qu",
+	"421003": "jing zhou qu",
+	"421022": "gong an xian",
+	"421024": "jiang ling xian",
+	"421071": "jing zhou jing ji ji shu kai fa qu",
+	"421081": "shi shou shi",
+	"421083": "hong hu shi",
+	"421087": "song zi shi",
+	"421088": "jian li shi",
+	"4211":   "huang gang shi",
+	"421102": "huang zhou qu",
+	"421121": "tuan feng xian",
+	"421122": "hong an xian",
+	"421123": "luo tian xian",
+	"421124": "ying shan xian",
+	"421125": "xi shui xian",
+	"421126": "qi chun xian",
+	"421127": "huang mei xian",
+	"421171": "long gan hu guan li qu",
+	"421181": "ma cheng shi",
+	"421182": "wu xue shi",
+	"4212":   "xian ning shi",
+	"421202": "xian an qu",
+	"421221": "jia yu xian",
+	"421222": "tong cheng xian",
+	"421223": "chong yang xian",
+	"421224": "tong shan xian",
+	"421281": "chi bi shi",
+	"4213":   "sui zhou shi",
+	"421303": "zeng du qu",
+	"421321": "sui xian",
+	"421381": "guang shui shi",
+	"4228":   "en shi tu jia zu miao zu zi zhi zhou",
+	"422801": "en shi shi",
+	"422802": "li chuan shi",
+	"422822": "jian shi xian",
+	"422823": "ba dong xian",
+	"422825": "xuan en xian",
+	"422826": "xian feng xian",
+	"422827": "lai feng xian",
+	"422828": "he feng xian",
+	"4290":   "sheng zhi xia xian ji xing zheng qu hua",
+	"429004": "xian tao shi",
+	"429005": "qian jiang shi",
+	"429006": "tian men shi",
+	"429021": "shen nong jia lin qu",
+	"43":     "hu nan sheng",
+	"4301":   "chang sha shi",
+	"430102": "fu rong qu",
+	"430103": "tian xin qu",
+	"430104": "yue lu qu",
+	"430105": "kai fu qu",
+	"430111": "yu hua qu",
+	"430112": "wang cheng qu",
+	"430121": "chang sha xian",
+	"430181": "liu yang shi",
+	"430182": "ning xiang shi",
+	"4302":   "zhu zhou shi",
+	"430202": "he tang qu",
+	"430203": "lu song qu",
+	"430204": "shi feng qu",
+	"430211": "tian yuan qu",
+	"430212": "lu kou qu",
+	"430223": "you xian",
+	"430224": "cha ling xian",
+	"430225": "yan ling xian",
+	"430281": "li ling shi",
+	"4303":   "xiang tan shi",
+	"430302": "yu hu qu",
+	"430304": "yue tang qu",
+	"430321": "xiang tan xian",
+	"430371": "hu nan xiang tan gao xin ji shu chan ye yuan qu",
+	"430372": "xiang tan zhao shan shi fan qu",
+	"430373": "xiang tan jiu hua shi fan qu",
+	"430381": "xiang xiang shi",
+	"430382": "shao shan shi",
+	"4304":   "heng yang shi",
+	"430405": "zhu hui qu",
+	"430406": "yan feng qu",
+	"430407": "shi gu qu",
+	"430408": "zheng xiang qu",
+	"430412": "nan yue qu",
+	"430421": "heng yang xian",
+	"430422": "heng nan xian",
+	"430423": "heng shan xian",
+	"430424": "heng dong xian",
+	"430426": "qi dong xian",
+	"430473": "hu nan heng yang song mu jing ji kai fa qu",
+	"430476": "hu nan heng yang gao xin ji shu chan ye yuan qu",
+	"430481": "lei yang shi",
+	"430482": "chang ning shi",
+	"4305":   "shao yang shi",
+	"430502": "shuang qing qu",
+	"430503": "da xiang qu",
+	"430511": "bei ta qu",
+	"430522": "xin shao xian",
+	"430523": "shao yang xian",
+	"430524": "long hui xian",
+	"430525": "dong kou xian",
+	"430527": "sui ning xian",
+	"430528": "xin ning xian",
+	"430529": "cheng bu miao zu zi zhi xian",
+	"430581": "wu gang shi",
+	"430582": "shao dong shi",
+	"4306":   "yue yang shi",
+	"430602": "yue yang lou qu",
+	"430603": "yun xi qu",
+	"430611": "jun shan qu",
+	"430621": "yue yang xian",
+	"430623": "hua rong xian",
+	"430624": "xiang yin xian",
+	"430626": "ping jiang xian",
+	"430671": "yue yang shi qu yuan guan li qu",
+	"430681": "mi luo shi",
+	"430682": "lin xiang shi",
+	"4307":   "chang de shi",
+	"430702": "wu ling qu",
+	"430703": "ding cheng qu",
+	"430721": "an xiang xian",
+	"430722": "han shou xian",
+	"430723": "li xian",
+	"430724": "lin li xian",
+	"430725": "tao yuan xian",
+	"430726": "shi men xian",
+	"430771": "chang de shi xi dong ting guan li qu",
+	"430781": "jin shi shi",
+	"4308":   "zhang jia jie shi",
+	"430802": "yong ding qu",
+	"430811": "wu ling yuan qu",
+	"430821": "ci li xian",
+	"430822": "sang zhi xian",
+	"4309":   "yi yang shi",
+	"430902": "zi yang qu",
+	"430903": "he shan qu",
+	"430921": "nan xian",
+	"430922": "tao jiang xian",
+	"430923": "an hua xian",
+	"430971": "yi yang shi da tong hu guan li qu",
+	"430972": "hu nan yi yang gao xin ji shu chan ye yuan qu",
+	"430981": "yuan jiang shi",
+	"4310":   "chen zhou shi",
+	"431002": "bei hu qu",
+	"431003": "su xian qu",
+	"431021": "gui yang xian",
+	"431022": "yi zhang xian",
+	"431023": "yong xing xian",
+	"431024": "jia he xian",
+	"431025": "lin wu xian",
+	"431026": "ru cheng xian",
+	"431027": "gui dong xian",
+	"431028": "an ren xian",
+	"431081": "zi xing shi",
+	"4311":   "yong zhou shi",
+	"431102": "ling ling qu",
+	"431103": "leng shui tan qu",
+	"431122": "dong an xian",
+	"431123": "shuang pai xian",
+	"431124": "dao xian",
+	"431125": "jiang yong xian",
+	"431126": "ning yuan xian",
+	"431127": "lan shan xian",
+	"431128": "xin tian xian",
+	"431129": "jiang hua yao zu zi zhi xian",
+	"431171": "yong zhou jing ji ji shu kai fa qu",
+	"431173": "yong zhou shi hui long xu guan li qu",
+	"431181": "qi yang shi",
+	"4312":   "huai hua shi",
+	"431202": "he cheng qu",
+	"431221": "zhong fang xian",
+	"431222": "yuan ling xian",
+	"431223": "chen xi xian",
+	"431224": "xu pu xian",
+	"431225": "hui tong xian",
+	"431226": "ma yang miao zu zi zhi xian",
+	"431227": "xin huang dong zu zi zhi xian",
+	"431228": "zhi jiang dong zu zi zhi xian",
+	"431229": "jing zhou miao zu dong zu zi zhi xian",
+	"431230": "tong dao dong zu zi zhi xian",
+	"431271": "huai hua shi hong jiang guan li qu",
+	"431281": "hong jiang shi",
+	"4313":   "lou di shi",
+	"431302": "lou xing qu",
+	"431321": "shuang feng xian",
+	"431322": "xin hua xian",
+	"431381": "leng shui jiang shi",
+	"431382": "lian yuan shi",
+	"4331":   "xiang xi tu jia zu miao zu zi zhi zhou",
+	"433101": "ji shou shi",
+	"433122": "lu xi xian",
+	"433123": "feng huang xian",
+	"433124": "hua yuan xian",
+	"433125": "bao jing xian",
+	"433126": "gu zhang xian",
+	"433127": "yong shun xian",
+	"433130": "long shan xian",
+	"44":     "guang dong sheng",
+	"4401":   "guang zhou shi",
+	"440103": "li wan qu",
+	"440104": "yue xiu qu",
+	"440105": "hai zhu qu",
+	"440106": "tian he qu",
+	"440111": "bai yun qu",
+	"440112": "huang pu qu",
+	"440113": "pan yu qu",
+	"440114": "hua du qu",
+	"440115": "nan sha qu",
+	"440117": "cong hua qu",
+	"440118": "zeng cheng qu",
+	"4402":   "shao guan shi",
+	"440203": "wu jiang qu",
+	"440204": "zhen jiang qu",
+	"440205": "qu jiang qu",
+	"440222": "shi xing xian",
+	"440224": "ren hua xian",
+	"440229": "weng yuan xian",
+	"440232": "ru yuan yao zu zi zhi xian",
+	"440233": "xin feng xian",
+	"440281": "le chang shi",
+	"440282": "nan xiong shi",
+	"4403":   "shen zhen shi",
+	"440303": "luo hu qu",
+	"440304": "fu tian qu",
+	"440305": "nan shan qu",
+	"440306": "bao an qu",
+	"440307": "long gang qu",
+	"440308": "yan tian qu",
+	"440309": "long hua qu",
+	"440310": "ping shan qu",
+	"440311": "guang ming qu",
+	"4404":   "zhu hai shi",
+	"440402": "xiang zhou qu",
+	"440403": "dou men qu",
+	"440404": "jin wan qu",
+	"4405":   "shan tou shi",
+	"440507": "long hu qu",
+	"440511": "jin ping qu",
+	"440512": "hao jiang qu",
+	"440513": "chao yang qu",
+	"440514": "chao nan qu",
+	"440515": "cheng hai qu",
+	"440523": "nan ao xian",
+	"4406":   "fo shan shi",
+	"440604": "chan cheng qu",
+	"440605": "nan hai qu",
+	"440606": "shun de qu",
+	"440607": "san shui qu",
+	"440608": "gao ming qu",
+	"4407":   "jiang men shi",
+	"440703": "peng jiang qu",
+	"440704": "jiang hai qu",
+	"440705": "xin hui qu",
+	"440781": "tai shan shi",
+	"440783": "kai ping shi",
+	"440784": "he shan shi",
+	"440785": "en ping shi",
+	"4408":   "zhan jiang shi",
+	"440802": "chi kan qu",
+	"440803": "xia shan qu",
+	"440804": "po tou qu",
+	"440811": "ma zhang qu",
+	"440823": "sui xi xian",
+	"440825": "xu wen xian",
+	"440881": "lian jiang shi",
+	"440882": "lei zhou shi",
+	"440883": "wu chuan shi",
+	"4409":   "mao ming shi",
+	"440902": "mao nan qu",
+	"440904": "dian bai qu",
+	"440981": "gao zhou shi",
+	"440982": "hua zhou shi",
+	"440983": "xin yi shi",
+	"4412":   "zhao qing shi",
+	"441202": "duan zhou qu",
+	"441203": "ding hu qu",
+	"441204": "gao yao qu",
+	"441223": "guang ning xian",
+	"441224": "huai ji xian",
+	"441225": "feng kai xian",
+	"441226": "de qing xian",
+	"441284": "si hui shi",
+	"4413":   "hui zhou shi",
+	"441302": "hui cheng qu",
+	"441303": "hui yang qu",
+	"441322": "bo luo xian",
+	"441323": "hui dong xian",
+	"441324": "long men xian",
+	"4414":   "mei zhou shi",
+	"441402": "mei jiang qu",
+	"441403": "mei xian qu",
+	"441422": "da bu xian",
+	"441423": "feng shun xian",
+	"441424": "wu hua xian",
+	"441426": "ping yuan xian",
+	"441427": "jiao ling xian",
+	"441481": "xing ning shi",
+	"4415":   "shan wei shi",
+	"441502": "cheng qu",
+	"441521": "hai feng xian",
+	"441523": "lu he xian",
+	"441581": "lu feng shi",
+	"4416":   "he yuan shi",
+	"441602": "yuan cheng qu",
+	"441621": "zi jin xian",
+	"441622": "long chuan xian",
+	"441623": "lian ping xian",
+	"441624": "he ping xian",
+	"441625": "dong yuan xian",
+	"4417":   "yang jiang shi",
+	"441702": "jiang cheng qu",
+	"441704": "yang dong qu",
+	"441721": "yang xi xian",
+	"441781": "yang chun shi",
+	"4418":   "qing yuan shi",
+	"441802": "qing cheng qu",
+	"441803": "qing xin qu",
+	"441821": "fo gang xian",
+	"441823": "yang shan xian",
+	"441825": "lian shan zhuang zu yao zu zi zhi xian",
+	"441826": "lian nan yao zu zi zhi xian",
+	"441881": "ying de shi",
+	"441882": "lian zhou shi",
+	"4419":   "dong guan shi",
+	"441900": "dong guan shi",
+	"4420":   "zhong shan shi",
+	"442000": "zhong shan shi",
+	"4451":   "chao zhou shi",
+	"445102": "xiang qiao qu",
+	"445103": "chao an qu",
+	"445122": "rao ping xian",
+	"4452":   "jie yang shi",
+	"445202": "rong cheng qu",
+	"445203": "jie dong qu",
+	"445222": "jie xi xian",
+	"445224": "hui lai xian",
+	"445281": "pu ning shi",
+	"4453":   "yun fu shi",
+	"445302": "yun cheng qu",
+	"445303": "yun an qu",
+	"445321": "xin xing xian",
+	"445322": "yu nan xian",
+	"445381": "luo ding shi",
+	"45":     "guang xi zhuang zu zi zhi qu",
+	"4501":   "nan ning shi",
+	"450102": "xing ning qu",
+	"450103": "qing xiu qu",
+	"450105": "jiang nan qu",
+	"450107": "xi xiang tang qu",
+	"450108": "liang qing qu",
+	"450109": "yong ning qu",
+	"450110": "wu ming qu",
+	"450123": "long an xian",
+	"450124": "ma shan xian",
+	"450125": "shang lin xian",
+	"450126": "bin yang xian",
+	"450181": "heng zhou shi",
+	"4502":   "liu zhou shi",
+	"450202": "cheng zhong qu",
+	"450203": "yu feng qu",
+	"450204": "liu nan qu",
+	"450205": "liu bei qu",
+	"450206": "liu jiang qu",
+	"450222": "liu cheng xian",
+	"450223": "lu zhai xian",
+	"450224": "rong an xian",
+	"450225": "rong shui miao zu zi zhi xian",
+	"450226": "san jiang dong zu zi zhi xian",
+	"4503":   "gui lin shi",
+	"450302": "xiu feng qu",
+	"450303": "die cai qu",
+	"450304": "xiang shan qu",
+	"450305": "qi xing qu",
+	"450311": "yan shan qu",
+	"450312": "lin gui qu",
+	"450321": "yang shuo xian",
+	"450323": "ling chuan xian",
+	"450324": "quan zhou xian",
+	"450325": "xing an xian",
+	"450326": "yong fu xian",
+	"450327": "guan yang xian",
+	"450328": "long sheng ge zu zi zhi xian",
+	"450329": "zi yuan xian",
+	"450330": "ping le xian",
+	"450332": "gong cheng yao zu zi zhi xian",
+	"450381": "li pu shi",
+	"4504":   "wu zhou shi",
+	"450403": "wan xiu qu",
+	"450405": "chang zhou qu",
+	"450406": "long xu qu",
+	"450421": "cang wu xian",
+	"450422": "teng xian",
+	"450423": "meng shan xian",
+	"450481": "cen xi shi",
+	"4505":   "bei hai shi",
+	"450502": "hai cheng qu",
+	"450503": "yin hai qu",
+	"450512": "tie shan gang qu",
+	"450521": "he pu xian",
+	"4506":   "fang cheng gang shi",
+	"450602": "gang kou qu",
+	"450603": "fang cheng qu",
+	"450621": "shang si xian",
+	"450681": "dong xing shi",
+	"4507":   "qin zhou shi",
+	"450702": "qin nan qu",
+	"450703": "qin bei qu",
+	"450721": "ling shan xian",
+	"450722": "pu bei xian",
+	"4508":   "gui gang shi",
+	"450802": "gang bei qu",
+	"450803": "gang nan qu",
+	"450804": "qin tang qu",
+	"450821": "ping nan xian",
+	"450881": "gui ping shi",
+	"4509":   "yu lin shi",
+	"450902": "yu zhou qu",
+	"450903": "fu mian qu",
+	"450921": "rong xian",
+	"450922": "lu chuan xian",
+	"450923": "bo bai xian",
+	"450924": "xing ye xian",
+	"450981": "bei liu shi",
+	"4510":   "bai se shi",
+	"451002": "you jiang qu",
+	"451003": "tian yang qu",
+	"451022": "tian dong xian",
+	"451024": "de bao xian",
+	"451026": "na po xian",
+	"451027": "ling yun xian",
+	"451028": "le ye xian",
+	"451029": "tian lin xian",
+	"451030": "xi lin xian",
+	"451031": "long lin ge zu zi zhi xian",
+	"451081": "jing xi shi",
+	"451082": "ping guo shi",
+	"4511":   "he zhou shi",
+	"451102": "ba bu qu",
+	"451103": "ping gui qu",
+	"451121": "zhao ping xian",
+	"451122": "zhong shan xian",
+	"451123": "fu chuan yao zu zi zhi xian",
+	"4512":   "he chi shi",
+	"451202": "jin cheng jiang qu",
+	"451203": "yi zhou qu",
+	"451221": "nan dan xian",
+	"451222": "tian e xian",
+	"451223": "feng shan xian",
+	"451224": "dong lan xian",
+	"451225": "luo cheng mu lao zu zi zhi xian",
+	"451226": "huan jiang mao nan zu zi zhi xian",
+	"451227": "ba ma yao zu zi zhi xian",
+	"451228": "du an yao zu zi zhi xian",
+	"451229": "da hua yao zu zi zhi xian",
+	"4513":   "lai bin shi",
+	"451302": "xing bin qu",
+	"451321": "xin cheng xian",
+	"451322": "xiang zhou xian",
+	"451323": "wu xuan xian",
+	"451324": "jin xiu yao zu zi zhi xian",
+	"451381": "he shan shi",
+	"4514":   "chong zuo shi",
+	"451402": "jiang zhou qu",
+	"451421": "fu sui xian",
+	"451422": "ning ming xian",
+	"451423": "long zhou xian",
+	"451424": "da xin xian",
+	"451425": "tian deng xian",
+	"451481": "ping xiang shi",
+	"46":     "hai nan sheng",
+	"4601":   "hai kou shi",
+	"460105": "xiu ying qu",
+	"460106": "long hua qu",
+	"460107": "qiong shan qu",
+	"460108": "mei lan qu",
+	"4602":   "san ya shi",
+	"460202": "hai tang qu",
+	"460203": "ji yang qu",
+	"460204": "tian ya qu",
+	"460205": "ya zhou qu",
+	"4603":   "san sha shi",
+	"460321": "xi sha qun dao",
+	"460322": "nan sha qun dao",
+	"460323": "zhong sha qun dao de dao jiao ji qi hai yu",
+	"4604":   "dan zhou shi",
+	"460400": "dan zhou shi",
+	"4690":   "sheng zhi xia xian ji xing zheng qu hua",
+	"469001": "wu zhi shan shi",
+	"469002": "qiong hai shi",
+	"469005": "wen chang shi",
+	"469006": "wan ning shi",
+	"469007": "dong fang shi",
+	"469021": "ding an xian",
+	"469022": "tun chang xian",
+	"469023": "cheng mai xian",
+	"469024": "lin gao xian",
+	"469025": "bai sha li zu zi zhi xian",
+	"469026": "chang jiang li zu zi zhi xian",
+	"469027": "le dong li zu zi zhi xian",
+	"469028": "ling shui li zu zi zhi xian",
+	"469029": "bao ting li zu miao zu zi zhi xian",
+	"469030": "qiong zhong li zu miao zu zi zhi xian",
+	"50":     "chong qing shi",
+	"5001":   "shi xia qu",
+	"500101": "wan zhou qu",
+	"500102": "fu ling qu",
+	"500103": "yu zhong qu",
+	"500104": "da du kou qu",
+	"500105": "jiang bei qu",
+	"500106": "sha ping ba qu",
+	"500107": "jiu long po qu",
+	"500108": "nan an qu",
+	"500109": "bei bei qu",
+	"500110": "qi jiang qu",
+	"500111": "da zu qu",
+	"500112": "yu bei qu",
+	"500113": "ba nan qu",
+	"500114": "qian jiang qu",
+	"500115": "chang shou qu",
+	"500116": "jiang jin qu",
+	"500117": "he chuan qu",
+	"500118": "yong chuan qu",
+	"500119": "nan chuan qu",
+	"500120": "bi shan qu",
+	"500151": "tong liang qu",
+	"500152": "tong nan qu",
+	"500153": "rong chang qu",
+	"500154": "kai zhou qu",
+	"500155": "liang ping qu",
+	"500156": "wu long qu",
+	"5002":   "xian",
+	"500229": "cheng kou xian",
+	"500230": "feng du xian",
+	"500231": "dian jiang xian",
+	"500233": "zhong xian",
+	"500235": "yun yang xian",
+	"500236": "feng jie xian",
+	"500237": "wu shan xian",
+	"500238": "wu xi xian",
+	"500240": "shi zhu tu jia zu zi zhi xian",
+	"500241": "xiu shan tu jia zu miao zu zi zhi xian",
+	"500242": "you yang tu jia zu miao zu zi zhi xian",
+	"500243": "peng shui miao zu tu jia zu zi zhi xian",
+	"51":     "si chuan sheng",
+	"5101":   "cheng du shi",
+	"510104": "jin jiang qu",
+	"510105": "qing yang qu",
+	"510106": "jin niu qu",
+	"510107": "wu hou qu",
+	"510108": "cheng hua qu",
+	"510112": "long quan yi qu",
+	"510113": "qing bai jiang qu",
+	"510114": "xin du qu",
+	"510115": "wen jiang qu",
+	"510116": "shuang liu qu",
+	"510117": "pi du qu",
+	"510118": "xin jin qu",
+	"510121": "jin tang xian",
+	"510129": "da yi xian",
+	"510131": "pu jiang xian",
+	"510181": "du jiang yan shi",
+	"510182": "peng zhou shi",
+	"510183": "qiong lai shi",
+	"510184": "chong zhou shi",
+	"510185": "jian yang shi",
+	"5103":   "zi gong shi",
+	"510302": "zi liu jing qu",
+	"510303": "gong jing qu",
+	"510304": "da an qu",
+	"510311": "yan tan qu",
+	"510321": "rong xian",
+	"510322": "fu shun xian",
+	"5104":   "pan zhi hua shi",
+	"510402": "dong qu",
+	"510403": "xi qu",
+	"510411": "ren he qu",
+	"510421": "mi yi xian",
+	"510422": "yan bian xian",
+	"5105":   "lu zhou shi",
+	"510502": "jiang yang qu",
+	"510503": "na xi qu",
+	"510504": "long ma tan qu",
+	"510521": "lu xian",
+	"510522": "he jiang xian",
+	"510524": "xu yong xian",
+	"510525": "gu lin xian",
+	"5106":   "de yang shi",
+	"510603": "jing yang qu",
+	"510604": "luo jiang qu",
+	"510623": "zhong jiang xian",
+	"510681": "guang han shi",
+	"510682": "shi fang shi",
+	"510683": "mian zhu shi",
+	"5107":   "mian yang shi",
+	"510703": "fu cheng qu",
+	"510704": "you xian qu",
+	"510705": "an zhou qu",
+	"510722": "san tai xian",
+	"510723": "yan ting xian",
+	"510725": "zi tong xian",
+	"510726": "bei chuan qiang zu zi zhi xian",
+	"510727": "ping wu xian",
+	"510781": "jiang you shi",
+	"5108":   "guang yuan shi",
+	"510802": "li zhou qu",
+	"510811": "zhao hua qu",
+	"510812": "chao tian qu",
+	"510821": "wang cang xian",
+	"510822": "qing chuan xian",
+	"510823": "jian ge xian",
+	"510824": "cang xi xian",
+	"5109":   "sui ning shi",
+	"510903": "chuan shan qu",
+	"510904": "an ju qu",
+	"510921": "peng xi xian",
+	"510923": "da ying xian",
+	"510981": "she hong shi",
+	"5110":   "nei jiang shi",
+	"511002": "shi zhong qu",
+	"511011": "dong xing qu",
+	"511024": "wei yuan xian",
+	"511025": "zi zhong xian",
+	"511083": "long chang shi",
+	"5111":   "le shan shi",
+	"511102": "shi zhong qu",
+	"511111": "sha wan qu",
+	"511112": "wu tong qiao qu",
+	"511113": "jin kou he qu",
+	"511123": "qian wei xian",
+	"511124": "jing yan xian",
+	"511126": "jia jiang xian",
+	"511129": "mu chuan xian",
+	"511132": "e bian yi zu zi zhi xian",
+	"511133": "ma bian yi zu zi zhi xian",
+	"511181": "e mei shan shi",
+	"5113":   "nan chong shi",
+	"511302": "shun qing qu",
+	"511303": "gao ping qu",
+	"511304": "jia ling qu",
+	"511321": "nan bu xian",
+	"511322": "ying shan xian",
+	"511323": "peng an xian",
+	"511324": "yi long xian",
+	"511325": "xi chong xian",
+	"511381": "lang zhong shi",
+	"5114":   "mei shan shi",
+	"511402": "dong po qu",
+	"511403": "peng shan qu",
+	"511421": "ren shou xian",
+	"511423": "hong ya xian",
+	"511424": "dan leng xian",
+	"511425": "qing shen xian",
+	"5115":   "yi bin shi",
+	"511502": "cui ping qu",
+	"511503": "nan xi qu",
+	"511504": "xu zhou qu",
+	"511523": "jiang an xian",
+	"511524": "chang ning xian",
+	"511525": "gao xian",
+	"511526": "gong xian",
+	"511527": "jun lian xian",
+	"511528": "xing wen xian",
+	"511529": "ping shan xian",
+	"5116":   "guang an shi",
+	"511602": "guang an qu",
+	"511603": "qian feng qu",
+	"511621": "yue chi xian",
+	"511622": "wu sheng xian",
+	"511623": "lin shui xian",
+	"511681": "hua ying shi",
+	"5117":   "da zhou shi",
+	"511702": "tong chuan qu",
+	"511703": "da chuan qu",
+	"511722": "xuan han xian",
+	"511723": "kai jiang xian",
+	"511724": "da zhu xian",
+	"511725": "qu xian",
+	"511781": "wan yuan shi",
+	"5118":   "ya an shi",
+	"511802": "yu cheng qu",
+	"511803": "ming shan qu",
+	"511822": "ying jing xian",
+	"511823": "han yuan xian",
+	"511824": "shi mian xian",
+	"511825": "tian quan xian",
+	"511826": "lu shan xian",
+	"511827": "bao xing xian",
+	"5119":   "ba zhong shi",
+	"511902": "ba zhou qu",
+	"511903": "en yang qu",
+	"511921": "tong jiang xian",
+	"511922": "nan jiang xian",
+	"511923": "ping chang xian",
+	"5120":   "zi yang shi",
+	"512002": "yan jiang qu",
+	"512021": "an yue xian",
+	"512022": "le zhi xian",
+	"5132":   "a ba zang zu qiang zu zi zhi zhou",
+	"513201": "ma er kang shi",
+	"513221": "wen chuan xian",
+	"513222": "li xian",
+	"513223": "mao xian",
+	"513224": "song pan xian",
+	"513225": "jiu zhai gou xian",
+	"513226": "jin chuan xian",
+	"513227": "xiao jin xian",
+	"513228": "hei shui xian",
+	"513230": "rang tang xian",
+	"513231": "a ba xian",
+	"513232": "ruo er gai xian",
+	"513233": "hong yuan xian",
+	"5133":   "gan zi zang zu zi zhi zhou",
+	"513301": "kang ding shi",
+	"513322": "lu ding xian",
+	"513323": "dan ba xian",
+	"513324": "jiu long xian",
+	"513325": "ya jiang xian",
+	"513326": "dao fu xian",
+	"513327": "lu huo xian",
+	"513328": "gan zi xian",
+	"513329": "xin long xian",
+	"513330": "de ge xian",
+	"513331": "bai yu xian",
+	"513332": "shi qu xian",
+	"513333": "se da xian",
+	"513334": "li tang xian",
+	"513335": "ba tang xian",
+	"513336": "xiang cheng xian",
+	"513337": "dao cheng xian",
+	"513338": "de rong xian",
+	"5134":   "liang shan yi zu zi zhi zhou",
+	"513401": "xi chang shi",
+	"513402": "hui li shi",
+	"513422": "mu li zang zu zi zhi xian",
+	"513423": "yan yuan xian",
+	"513424": "de chang xian",
+	"513426": "hui dong xian",
+	"513427": "ning nan xian",
+	"513428": "pu ge xian",
+	"513429": "bu tuo xian",
+	"513430": "jin yang xian",
+	"513431": "zhao jue xian",
+	"513432": "xi de xian",
+	"513433": "mian ning xian",
+	"513434": "yue xi xian",
+	"513435": "gan luo xian",
+	"513436": "mei gu xian",
+	"513437": "lei bo xian",
+	"52":     "gui zhou sheng",
+	"5201":   "gui yang shi",
+	"520102": "nan ming qu",
+	"520103": "yun yan qu",
+	"520111": "hua xi qu",
+	"520112": "wu dang qu",
+	"520113": "bai yun qu",
+	"520115": "guan shan hu qu",
+	"520121": "kai yang xian",
+	"520122": "xi feng xian",
+	"520123": "xiu wen xian",
+	"520181": "qing zhen shi",
+	"5202":   "liu pan shui shi",
+	"520201": "zhong shan qu",
+	"520203": "liu zhi te qu",
+	"520204": "shui cheng qu",
+	"520281": "pan zhou shi",
+	"5203":   "zun yi shi",
+	"520302": "hong hua gang qu",
+	"520303": "hui chuan qu",
+	"520304": "bo zhou qu",
+	"520322": "tong zi xian",
+	"520323": "sui yang xian",
+	"520324": "zheng an xian",
+	"520325": "dao zhen ge lao zu miao zu zi zhi xian",
+	"520326": "wu chuan ge lao zu miao zu zi zhi xian",
+	"520327": "feng gang xian",
+	"520328": "mei tan xian",
+	"520329": "yu qing xian",
+	"520330": "xi shui xian",
+	"520381": "chi shui shi",
+	"520382": "ren huai shi",
+	"5204":   "an shun shi",
+	"520402": "xi xiu qu",
+	"520403": "ping ba qu",
+	"520422": "pu ding xian",
+	"520423": "zhen ning bu yi zu miao zu zi zhi xian",
+	"520424": "guan ling bu yi zu miao zu zi zhi xian",
+	"520425": "zi yun miao zu bu yi zu zi zhi xian",
+	"5205":   "bi jie shi",
+	"520502": "qi xing guan qu",
+	"520521": "da fang xian",
+	"520523": "jin sha xian",
+	"520524": "zhi jin xian",
+	"520525": "na yong xian",
+	"520526": "wei ning yi zu hui zu miao zu zi zhi xian",
+	"520527": "he zhang xian",
+	"520581": "qian xi shi",
+	"5206":   "tong ren shi",
+	"520602": "bi jiang qu",
+	"520603": "wan shan qu",
+	"520621": "jiang kou xian",
+	"520622": "yu ping dong zu zi zhi xian",
+	"520623": "shi qian xian",
+	"520624": "si nan xian",
+	"520625": "yin jiang tu jia zu miao zu zi zhi xian",
+	"520626": "de jiang xian",
+	"520627": "yan he tu jia zu zi zhi xian",
+	"520628": "song tao miao zu zi zhi xian",
+	"5223":   "qian xi nan bu yi zu miao zu zi zhi zhou",
+	"522301": "xing yi shi",
+	"522302": "xing ren shi",
+	"522323": "pu an xian",
+	"522324": "qing long xian",
+	"522325": "zhen feng xian",
+	"522326": "wang mo xian",
+	"522327": "ce heng xian",
+	"522328": "an long xian",
+	"5226":   "qian dong nan miao zu dong zu zi zhi zhou",
+	"522601": "kai li shi",
+	"522622": "huang ping xian",
+	"522623": "shi bing xian",
+	"522624": "san sui xian",
+	"522625": "zhen yuan xian",
+	"522626": "cen gong xian",
+	"522627": "tian zhu xian",
+	"522628": "jin ping xian",
+	"522629": "jian he xian",
+	"522630": "tai jiang xian",
+	"522631": "li ping xian",
+	"522632": "rong jiang xian",
+	"522633": "cong jiang xian",
+	"522634": "lei shan xian",
+	"522635": "ma jiang xian",
+	"522636": "dan zhai xian",
+	"5227":   "qian nan bu yi zu miao zu zi zhi zhou",
+	"522701": "du yun shi",
+	"522702": "fu quan shi",
+	"522722": "li bo xian",
+	"522723": "gui ding xian",
+	"522725": "weng an xian",
+	"522726": "du shan xian",
+	"522727": "ping tang xian",
+	"522728": "luo dian xian",
+	"522729": "chang shun xian",
+	"522730": "long li xian",
+	"522731": "hui shui xian",
+	"522732": "san du shui zu zi zhi xian",
+	"53":     "yun nan sheng",
+	"5301":   "kun ming shi",
+	"530102": "wu hua qu",
+	"530103": "pan long qu",
+	"530111": "guan du qu",
+	"530112": "xi shan qu",
+	"530113": "dong chuan qu",
+	"530114": "cheng gong qu",
+	"530115": "jin ning qu",
+	"530124": "fu min xian",
+	"530125": "yi liang xian",
+	"530126": "shi lin yi zu zi zhi xian",
+	"530127": "song ming xian",
+	"530128": "lu quan yi zu miao zu zi zhi xian",
+	"530129": "xun dian hui zu yi zu zi zhi xian",
+	"530181": "an ning shi",
+	"5303":   "qu jing shi",
+	"530302": "qi lin qu",
+	"530303": "zhan yi qu",
+	"530304": "ma long qu",
+	"530322": "lu liang xian",
+	"530323": "shi zong xian",
+	"530324": "luo ping xian",
+	"530325": "fu yuan xian",
+	"530326": "hui ze xian",
+	"530381": "xuan wei shi",
+	"5304":   "yu xi shi",
+	"530402": "hong ta qu",
+	"530403": "jiang chuan qu",
+	"530423": "tong hai xian",
+	"530424": "hua ning xian",
+	"530425": "yi men xian",
+	"530426": "e shan yi zu zi zhi xian",
+	"530427": "xin ping yi zu dai zu zi zhi xian",
+	"530428": "yuan jiang ha ni zu yi zu dai zu zi zhi xian",
+	"530481": "cheng jiang shi",
+	"5305":   "bao shan shi",
+	"530502": "long yang qu",
+	"530521": "shi dian xian",
+	"530523": "long ling xian",
+	"530524": "chang ning xian",
+	"530581": "teng chong shi",
+	"5306":   "zhao tong shi",
+	"530602": "zhao yang qu",
+	"530621": "lu dian xian",
+	"530622": "qiao jia xian",
+	"530623": "yan jin xian",
+	"530624": "da guan xian",
+	"530625": "yong shan xian",
+	"530626": "sui jiang xian",
+	"530627": "zhen xiong xian",
+	"530628": "yi liang xian",
+	"530629": "wei xin xian",
+	"530681": "shui fu shi",
+	"5307":   "li jiang shi",
+	"530702": "gu cheng qu",
+	"530721": "yu long na xi zu zi zhi xian",
+	"530722": "yong sheng xian",
+	"530723": "hua ping xian",
+	"530724": "ning lang yi zu zi zhi xian",
+	"5308":   "pu er shi",
+	"530802": "si mao qu",
+	"530821": "ning er ha ni zu yi zu zi zhi xian",
+	"530822": "mo jiang ha ni zu zi zhi xian",
+	"530823": "jing dong yi zu zi zhi xian",
+	"530824": "jing gu dai zu yi zu zi zhi xian",
+	"530825": "zhen yuan yi zu ha ni zu la hu zu zi zhi xian",
+	"530826": "jiang cheng ha ni zu yi zu zi zhi xian",
+	"530827": "meng lian dai zu la hu zu wa zu zi zhi xian",
+	"530828": "lan cang la hu zu zi zhi xian",
+	"530829": "xi meng wa zu zi zhi xian",
+	"5309":   "lin cang shi",
+	"530902": "lin xiang qu",
+	"530921": "feng qing xian",
+	"530922": "yun xian",
+	"530923": "yong de xian",
+	"530924": "zhen kang xian",
+	"530925": "shuang jiang la hu zu wa zu bu lang zu dai zu zi zhi xian",
+	"530926": "geng ma dai zu wa zu zi zhi xian",
+	"530927": "cang yuan wa zu zi zhi xian",
+	"5323":   "chu xiong yi zu zi zhi zhou",
+	"532301": "chu xiong shi",
+	"532302": "lu feng shi",
+	"532322": "shuang bai xian",
+	"532323": "mou ding xian",
+	"532324": "nan hua xian",
+	"532325": "yao an xian",
+	"532326": "da yao xian",
+	"532327": "yong ren xian",
+	"532328": "yuan mou xian",
+	"532329": "wu ding xian",
+	"5325":   "hong he ha ni zu yi zu zi zhi zhou",
+	"532501": "ge jiu shi",
+	"532502": "kai yuan shi",
+	"532503": "meng zi shi",
+	"532504": "mi le shi",
+	"532523": "ping bian miao zu zi zhi xian",
+	"532524": "jian shui xian",
+	"532525": "shi ping xian",
+	"532527": "lu xi xian",
+	"532528": "yuan yang xian",
+	"532529": "hong he xian",
+	"532530": "jin ping miao zu yao zu dai zu zi zhi xian",
+	"532531": "lv chun xian",
+	"532532": "he kou yao zu zi zhi xian",
+	"5326":   "wen shan zhuang zu miao zu zi zhi zhou",
+	"532601": "wen shan shi",
+	"532622": "yan shan xian",
+	"532623": "xi chou xian",
+	"532624": "ma li po xian",
+	"532625": "ma guan xian",
+	"532626": "qiu bei xian",
+	"532627": "guang nan xian",
+	"532628": "fu ning xian",
+	"5328":   "xi shuang ban na dai zu zi zhi zhou",
+	"532801": "jing hong shi",
+	"532822": "meng hai xian",
+	"532823": "meng la xian",
+	"5329":   "da li bai zu zi zhi zhou",
+	"532901": "da li shi",
+	"532922": "yang bi yi zu zi zhi xian",
+	"532923": "xiang yun xian",
+	"532924": "bin chuan xian",
+	"532925": "mi du xian",
+	"532926": "nan jian yi zu zi zhi xian",
+	"532927": "wei shan yi zu hui zu zi zhi xian",
+	"532928": "yong ping xian",
+	"532929": "yun long xian",
+	"532930": "er yuan xian",
+	"532931": "jian chuan xian",
+	"532932": "he qing xian",
+	"5331":   "de hong dai zu jing po zu zi zhi zhou",
+	"533102": "rui li shi",
+	"533103": "mang shi",
+	"533122": "liang he xian",
+	"533123": "ying jiang xian",
+	"533124": "long chuan xian",
+	"5333":   "nu jiang li su zu zi zhi zhou",
+	"533301": "lu shui shi",
+	"533323": "fu gong xian",
+	"533324": "gong shan du long zu nu zu zi zhi xian",
+	"533325": "lan ping bai zu pu mi zu zi zhi xian",
+	"5334":   "di qing zang zu zi zhi zhou",
+	"533401": "xiang ge li la shi",
+	"533422": "de qin xian",
+	"533423": "wei xi li su zu zi zhi xian",
+	"54":     "xi zang zi zhi qu",
+	"5401":   "la sa shi",
+	"540102": "cheng guan qu",
+	"540103": "dui long de qing qu",
+	"540104": "da zi qu",
+	"540121": "lin zhou xian",
+	"540122": "dang xiong xian",
+	"540123": "ni mu xian",
+	"540124": "qu shui xian",
+	"540127": "mo zhu gong ka xian",
+	"540171": "ge er mu zang qing gong ye yuan qu",
+	"540172": "la sa jing ji ji shu kai fa qu",
+	"540173": "xi zang wen hua lv you chuang yi yuan qu",
+	"540174": "da zi gong ye yuan qu",
+	"5402":   "ri ka ze shi",
+	"540202": "sang zhu zi qu",
+	"540221": "nan mu lin xian",
+	"540222": "jiang zi xian",
+	"540223": "ding ri xian",
+	"540224": "sa jia xian",
+	"540225": "la zi xian",
+	"540226": "ang ren xian",
+	"540227": "xie tong men xian",
+	"540228": "bai lang xian",
+	"540229": "ren bu xian",
+	"540230": "kang ma xian",
+	"540231": "ding jie xian",
+	"540232": "zhong ba xian",
+	"540233": "ya dong xian",
+	"540234": "ji long xian",
+	"540235": "nie la mu xian",
+	"540236": "sa ga xian",
+	"540237": "gang ba xian",
+	"5403":   "chang du shi",
+	"540302": "ka ruo qu",
+	"540321": "jiang da xian",
+	"540322": "gong jue xian",
+	"540323": "lei wu qi xian",
+	"540324": "ding qing xian",
+	"540325": "cha ya xian",
+	"540326": "ba su xian",
+	"540327": "zuo gong xian",
+	"540328": "mang kang xian",
+	"540329": "luo long xian",
+	"540330": "bian ba xian",
+	"5404":   "lin zhi shi",
+	"540402": "ba yi qu",
+	"540421": "gong bu jiang da xian",
+	"540423": "mo tuo xian",
+	"540424": "bo mi xian",
+	"540425": "cha yu xian",
+	"540426": "lang xian",
+	"540481": "mi lin shi",
+	"5405":   "shan nan shi",
+	"540502": "nai dong qu",
+	"540521": "zha nang xian",
+	"540522": "gong ga xian",
+	"540523": "sang ri xian",
+	"540524": "qiong jie xian",
+	"540525": "qu song xian",
+	"540526": "cuo mei xian",
+	"540527": "luo zha xian",
+	"540528": "jia cha xian",
+	"540529": "long zi xian",
+	"540531": "lang ka zi xian",
+	"540581": "cuo na shi",
+	"5406":   "na qu shi",
+	"540602": "se ni qu",
+	"540621": "jia li xian",
+	"540622": "bi ru xian",
+	"540623": "nie rong xian",
+	"540624": "an duo xian",
+	"540625": "shen zha xian",
+	"540626": "suo xian",
+	"540627": "ban ge xian",
+	"540628": "ba qing xian",
+	"540629": "ni ma xian",
+	"540630": "shuang hu xian",
+	"5425":   "a li di qu",
+	"542521": "pu lan xian",
+	"542522": "zha da xian",
+	"542523": "ga er xian",
+	"542524": "ri tu xian",
+	"542525": "ge ji xian",
+	"542526": "gai ze xian",
+	"542527": "cuo qin xian",
+	"61":     "shan xi sheng",
+	"6101":   "xi an shi",
+	"610102": "xin cheng qu",
+	"610103": "bei lin qu",
+	"610104": "lian hu qu",
+	"610111": "ba qiao qu",
+	"610112": "wei yang qu",
+	"610113": "yan ta qu",
+	"610114": "yan liang qu",
+	"610115": "lin tong qu",
+	"610116": "chang an qu",
+	"610117": "gao ling qu",
+	"610118": "hu yi qu",
+	"610122": "lan tian xian",
+	"610124": "zhou zhi xian",
+	"6102":   "tong chuan shi",
+	"610202": "wang yi qu",
+	"610203": "yin tai qu",
+	"610204": "yao zhou qu",
+	"610222": "yi jun xian",
+	"6103":   "bao ji shi",
+	"610302": "wei bin qu",
+	"610303": "jin tai qu",
+	"610304": "chen cang qu",
+	"610305": "feng xiang qu",
+	"610323": "qi shan xian",
+	"610324": "fu feng xian",
+	"610326": "mei xian",
+	"610327": "long xian",
+	"610328": "qian yang xian",
+	"610329": "lin you xian",
+	"610330": "feng xian",
+	"610331": "tai bai xian",
+	"6104":   "xian yang shi",
+	"610402": "qin du qu",
+	"610403": "yang ling qu",
+	"610404": "wei cheng qu",
+	"610422": "san yuan xian",
+	"610423": "jing yang xian",
+	"610424": "qian xian",
+	"610425": "li quan xian",
+	"610426": "yong shou xian",
+	"610428": "chang wu xian",
+	"610429": "xun yi xian",
+	"610430": "chun hua xian",
+	"610431": "wu gong xian",
+	"610481": "xing ping shi",
+	"610482": "bin zhou shi",
+	"6105":   "wei nan shi",
+	"610502": "lin wei qu",
+	"610503": "hua zhou qu",
+	"610522": "tong guan xian",
+	"610523": "da li xian",
+	"610524": "he yang xian",
+	"610525": "cheng cheng xian",
+	"610526": "pu cheng xian",
+	"610527": "bai shui xian",
+	"610528": "fu ping xian",
+	"610581": "han cheng shi",
+	"610582": "hua yin shi",
+	"6106":   "yan an shi",
+	"610602": "bao ta qu",
+	"610603": "an sai qu",
+	"610621": "yan chang xian",
+	"610622": "yan chuan xian",
+	"610625": "zhi dan xian",
+	"610626": "wu qi xian",
+	"610627": "gan quan xian",
+	"610628": "fu xian",
+	"610629": "luo chuan xian",
+	"610630": "yi chuan xian",
+	"610631": "huang long xian",
+	"610632": "huang ling xian",
+	"610681": "zi chang shi",
+	"6107":   "han zhong shi",
+	"610702": "han tai qu",
+	"610703": "nan zheng qu",
+	"610722": "cheng gu xian",
+	"610723": "yang xian",
+	"610724": "xi xiang xian",
+	"610725": "mian xian",
+	"610726": "ning qiang xian",
+	"610727": "lve yang xian",
+	"610728": "zhen ba xian",
+	"610729": "liu ba xian",
+	"610730": "fo ping xian",
+	"6108":   "yu lin shi",
+	"610802": "yu yang qu",
+	"610803": "heng shan qu",
+	"610822": "fu gu xian",
+	"610824": "jing bian xian",
+	"610825": "ding bian xian",
+	"610826": "sui de xian",
+	"610827": "mi zhi xian",
+	"610828": "jia xian",
+	"610829": "wu bu xian",
+	"610830": "qing jian xian",
+	"610831": "zi zhou xian",
+	"610881": "shen mu shi",
+	"6109":   "an kang shi",
+	"610902": "han bin qu",
+	"610921": "han yin xian",
+	"610922": "shi quan xian",
+	"610923": "ning shan xian",
+	"610924": "zi yang xian",
+	"610925": "lan gao xian",
+	"610926": "ping li xian",
+	"610927": "zhen ping xian",
+	"610929": "bai he xian",
+	"610981": "xun yang shi",
+	"6110":   "shang luo shi",
+	"611002": "shang zhou qu",
+	"611021": "luo nan xian",
+	"611022": "dan feng xian",
+	"611023": "shang nan xian",
+	"611024": "shan yang xian",
+	"611025": "zhen an xian",
+	"611026": "zha shui xian",
+	"62":     "gan su sheng",
+	"6201":   "lan zhou shi",
+	"620102": "cheng guan qu",
+	"620103": "qi li he qu",
+	"620104": "xi gu qu",
+	"620105": "an ning qu",
+	"620111": "hong gu qu",
+	"620121": "yong deng xian",
+	"620122": "gao lan xian",
+	"620123": "yu zhong xian",
+	"620171": "lan zhou xin qu",
+	"6202":   "jia yu guan shi",
+	"620201": "jia yu guan shi",
+	"6203":   "jin chang shi",
+	"620302": "jin chuan qu",
+	"620321": "yong chang xian",
+	"6204":   "bai yin shi",
+	"620402": "bai yin qu",
+	"620403": "ping chuan qu",
+	"620421": "jing yuan xian",
+	"620422": "hui ning xian",
+	"620423": "jing tai xian",
+	"6205":   "tian shui shi",
+	"620502": "qin zhou qu",
+	"620503": "mai ji qu",
+	"620521": "qing shui xian",
+	"620522": "qin an xian",
+	"620523": "gan gu xian",
+	"620524": "wu shan xian",
+	"620525": "zhang jia chuan hui zu zi zhi xian",
+	"6206":   "wu wei shi",
+	"620602": "liang zhou qu",
+	"620621": "min qin xian",
+	"620622": "gu lang xian",
+	"620623": "tian zhu zang zu zi zhi xian",
+	"6207":   "zhang ye shi",
+	"620702": "gan zhou qu",
+	"620721": "su nan yu gu zu zi zhi xian",
+	"620722": "min le xian",
+	"620723": "lin ze xian",
+	"620724": "gao tai xian",
+	"620725": "shan dan xian",
+	"6208":   "ping liang shi",
+	"620802": "kong tong qu",
+	"620821": "jing chuan xian",
+	"620822": "ling tai xian",
+	"620823": "chong xin xian",
+	"620825": "zhuang lang xian",
+	"620826": "jing ning xian",
+	"620881": "hua ting shi",
+	"6209":   "jiu quan shi",
+	"620902": "su zhou qu",
+	"620921": "jin ta xian",
+	"620922": "gua zhou xian",
+	"620923": "su bei meng gu zu zi zhi xian",
+	"620924": "a ke sai ha sa ke zu zi zhi xian",
+	"620981": "yu men shi",
+	"620982": "dun huang shi",
+	"6210":   "qing yang shi",
+	"621002": "xi feng qu",
+	"621021": "qing cheng xian",
+	"621022": "huan xian",
+	"621023": "hua chi xian",
+	"621024": "he shui xian",
+	"621025": "zheng ning xian",
+	"621026": "ning xian",
+	"621027": "zhen yuan xian",
+	"6211":   "ding xi shi",
+	"621102": "an ding qu",
+	"621121": "tong wei xian",
+	"621122": "long xi xian",
+	"621123": "wei yuan xian",
+	"621124": "lin tao xian",
+	"621125": "zhang xian",
+	"621126": "min xian",
+	"6212":   "long nan shi",
+	"621202": "wu du qu",
+	"621221": "cheng xian",
+	"621222": "wen xian",
+	"621223": "dang chang xian",
+	"621224": "kang xian",
+	"621225": "xi he xian",
+	"621226": "li xian",
+	"621227": "hui xian",
+	"621228": "liang dang xian",
+	"6229":   "lin xia hui zu zi zhi zhou",
+	"622901": "lin xia shi",
+	"622921": "lin xia xian",
+	"622922": "kang le xian",
+	"622923": "yong jing xian",
+	"622924": "guang he xian",
+	"622925": "he zheng xian",
+	"622926": "dong xiang zu zi zhi xian",
+	"622927": "ji shi shan bao an zu dong xiang zu sa la zu zi zhi xian",
+	"6230":   "gan nan zang zu zi zhi zhou",
+	"623001": "he zuo shi",
+	"623021": "lin tan xian",
+	"623022": "zhuo ni xian",
+	"623023": "zhou qu xian",
+	"623024": "die bu xian",
+	"623025": "ma qu xian",
+	"623026": "lu qu xian",
+	"623027": "xia he xian",
+	"63":     "qing hai sheng",
+	"6301":   "xi ning shi",
+	"630102": "cheng dong qu",
+	"630103": "cheng zhong qu",
+	"630104": "cheng xi qu",
+	"630105": "cheng bei qu",
+	"630106": "huang zhong qu",
+	"630121": "da tong hui zu tu zu zi zhi xian",
+	"630123": "huang yuan xian",
+	"6302":   "hai dong shi",
+	"630202": "le du qu",
+	"630203": "ping an qu",
+	"630222": "min he hui zu tu zu zi zhi xian",
+	"630223": "hu zhu tu zu zi zhi xian",
+	"630224": "hua long hui zu zi zhi xian",
+	"630225": "xun hua sa la zu zi zhi xian",
+	"6322":   "hai bei zang zu zi zhi zhou",
+	"632221": "men yuan hui zu zi zhi xian",
+	"632222": "qi lian xian",
+	"632223": "hai yan xian",
+	"632224": "gang cha xian",
+	"6323":   "huang nan zang zu zi zhi zhou",
+	"632301": "tong ren shi",
+	"632322": "jian zha xian",
+	"632323": "ze ku xian",
+	"632324": "he nan meng gu zu zi zhi xian",
+	"6325":   "hai nan zang zu zi zhi zhou",
+	"632521": "gong he xian",
+	"632522": "tong de xian",
+	"632523": "gui de xian",
+	"632524": "xing hai xian",
+	"632525": "gui nan xian",
+	"6326":   "guo luo zang zu zi zhi zhou",
+	"632621": "ma qin xian",
+	"632622": "ban ma xian",
+	"632623": "gan de xian",
+	"632624": "da ri xian",
+	"632625": "jiu zhi xian",
+	"632626": "ma duo xian",
+	"6327":   "yu shu zang zu zi zhi zhou",
+	"632701": "yu shu shi",
+	"632722": "za duo xian",
+	"632723": "chen duo xian",
+	"632724": "zhi duo xian",
+	"632725": "nang qian xian",
+	"632726": "qu ma lai xian",
+	"6328":   "hai xi meng gu zu zang zu zi zhi zhou",
+	"632801": "ge er mu shi",
+	"632802": "de ling ha shi",
+	"632803": "mang ya shi",
+	"632821": "wu lan xian",
+	"632822": "du lan xian",
+	"632823": "tian jun xian",
+	"632857": "da chai dan xing zheng wei yuan hui",
+	"64":     "ning xia hui zu zi zhi qu",
+	"6401":   "yin chuan shi",
+	"640104": "xing qing qu",
+	"640105": "xi xia qu",
+	"640106": "jin feng qu",
+	"640121": "yong ning xian",
+	"640122": "he lan xian",
+	"640181": "ling wu shi",
+	"6402":   "shi zui shan shi",
+	"640202": "da wu kou qu",
+	"640205": "hui nong qu",
+	"640221": "ping luo xian",
+	"6403":   "wu zhong shi",
+	"640302": "li tong qu",
+	"640303": "hong si bu qu",
+	"640323": "yan chi xian",
+	"640324": "tong xin xian",
+	"640381": "qing tong xia shi",
+	"6404":   "gu yuan shi",
+	"640402": "yuan zhou qu",
+	"640422": "xi ji xian",
+	"640423": "long de xian",
+	"640424": "jing yuan xian",
+	"640425": "peng yang xian",
+	"6405":   "zhong wei shi",
+	"640502": "sha po tou qu",
+	"640521": "zhong ning xian",
+	"640522": "hai yuan xian",
+	"65":     "xin jiang wei wu er zi zhi qu",
+	"6501":   "wu lu mu qi shi",
+	"650102": "tian shan qu",
+	"650103": "sha yi ba ke qu",
+	"650104": "xin shi qu",
+	"650105": "shui mo gou qu",
+	"650106": "tou tun he qu",
+	"650107": "da ban cheng qu",
+	"650109": "mi dong qu",
+	"650121": "wu lu mu qi xian",
+	"6502":   "ke la ma yi shi",
+	"650202": "du shan zi qu",
+	"650203": "ke la ma yi qu",
+	"650204": "bai jian tan qu",
+	"650205": "wu er he qu",
+	"6504":   "tu lu fan shi",
+	"650402": "gao chang qu",
+	"650421": "shan shan xian",
+	"650422": "tuo ke xun xian",
+	"6505":   "ha mi shi",
+	"650502": "yi zhou qu",
+	"650521": "ba li kun ha sa ke zi zhi xian",
+	"650522": "yi wu xian",
+	"6523":   "chang ji hui zu zi zhi zhou",
+	"652301": "chang ji shi",
+	"652302": "fu kang shi",
+	"652323": "hu tu bi xian",
+	"652324": "ma na si xian",
+	"652325": "qi tai xian",
+	"652327": "ji mu sa er xian",
+	"652328": "mu lei ha sa ke zi zhi xian",
+	"6527":   "bo er ta la meng gu zi zhi zhou",
+	"652701": "bo le shi",
+	"652702": "a la shan kou shi",
+	"652722": "jing he xian",
+	"652723": "wen quan xian",
+	"6528":   "ba yin guo leng meng gu zi zhi zhou",
+	"652801": "ku er le shi",
+	"652822": "lun tai xian",
+	"652823": "yu li xian",
+	"652824": "ruo qiang xian",
+	"652825": "qie mo xian",
+	"652826": "yan qi hui zu zi zhi xian",
+	"652827": "he jing xian",
+	"652828": "he shuo xian",
+	"652829": "bo hu xian",
+	"6529":   "a ke su di qu",
+	"652901": "a ke su shi",
+	"652902": "ku che shi",
+	"652922": "wen su xian",
+	"652924": "sha ya xian",
+	"652925": "xin he xian",
+	"652926": "bai cheng xian",
+	"652927": "wu shi xian",
+	"652928": "a wa ti xian",
+	"652929": "ke ping xian",
+	"6530":   "ke zi le su ke er ke zi zi zhi zhou",
+	"653001": "a tu shi shi",
+	"653022": "a ke tao xian",
+	"653023": "a he qi xian",
+	"653024": "wu qia xian",
+	"6531":   "ka shi di qu",
+	"653101": "ka shi shi",
+	"653121": "shu fu xian",
+	"653122": "shu le xian",
+	"653123": "ying ji sha xian",
+	"653124": "ze pu xian",
+	"653125": "sha che xian",
+	"653126": "ye cheng xian",
+	"653127": "mai gai ti xian",
+	"653128": "yue pu hu xian",
+	"653129": "jia shi xian",
+	"653130": "ba chu xian",
+	"653131": "ta shi ku er gan ta ji ke zi zhi xian",
+	"6532":   "he tian di qu",
+	"653201": "he tian shi",
+	"653221": "he tian xian",
+	"653222": "mo yu xian",
+	"653223": "pi shan xian",
+	"653224": "luo pu xian",
+	"653225": "ce le xian",
+	"653226": "yu tian xian",
+	"653227": "min feng xian",
+	"6540":   "yi li ha sa ke zi zhi zhou",
+	"654002": "yi ning shi",
+	"654003": "kui tun shi",
+	"654004": "huo er guo si shi",
+	"654021": "yi ning xian",
+	"654022": "cha bu cha er xi bo zi zhi xian",
+	"654023": "huo cheng xian",
+	"654024": "gong liu xian",
+	"654025": "xin yuan xian",
+	"654026": "zhao su xian",
+	"654027": "te ke si xian",
+	"654028": "ni le ke xian",
+	"6542":   "ta cheng di qu",
+	"654201": "ta cheng shi",
+	"654202": "wu su shi",
+	"654203": "sha wan shi",
+	"654221": "e min xian",
+	"654224": "tuo li xian",
+	"654225": "yu min xian",
+	"654226": "he bu ke sai er meng gu zi zhi xian",
+	"6543":   "a le tai di qu",
+	"654301": "a le tai shi",
+	"654321": "bu er jin xian",
+	"654322": "fu yun xian",
+	"654323": "fu hai xian",
+	"654324": "ha ba he xian",
+	"654325": "qing he xian",
+	"654326": "ji mu nai xian",
+	"6590":   "zi zhi qu zhi xia xian ji xing zheng qu hua",
+	"659001": "shi he zi shi",
+	"659002": "a la er shi",
+	"659003": "tu mu shu ke shi",
+	"659004": "wu jia qu shi",
+	"659005": "bei tun shi",
+	"659006": "tie men guan shi",
+	"659007": "shuang he shi",
+	"659008": "ke ke da la shi",
+	"659009": "kun yu shi",
+	"659010": "hu yang he shi",
+	"659011": "xin xing shi",
+	"659012": "bai yang shi",
+	"71":     "tai wan sheng",
+	"81":     "xiang gang te bie xing zheng qu",
+	"82":     "ao men te bie xing zheng qu",
+}
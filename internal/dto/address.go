@@ -0,0 +1,34 @@
+package dto
+
+// Address 地址信息，需要填写省市区的表单可直接嵌入
+//
+// 除了字段级的编码校验，还会通过结构体级校验 ValidAddress 检查区县属于所选城市、城市属于所选省份
+type Address struct {
+	ProvinceCode string `json:"province_code" form:"province_code" binding:"required,province_code"`              // 省份编码
+	CityCode     string `json:"city_code" form:"city_code" binding:"required,city_code"`                          // 城市编码
+	DistrictCode string `json:"district_code" form:"district_code" binding:"required,district_code"`              // 区县编码
+	Detail       string `json:"detail" form:"detail" binding:"max=200" normalize:"trim,halfwidth,collapse_space"` // 详细地址
+}
+
+// AddressParseRequest 地址解析请求
+type AddressParseRequest struct {
+	Address string `json:"address" binding:"required,max=200" normalize:"trim,halfwidth,collapse_space"` // 待解析的地址，如"浙江杭州西湖区文三路100号"
+}
+
+// AddressRegion 省市区编码及名称
+type AddressRegion struct {
+	ProvinceCode string `json:"province_code"` // 省份编码
+	ProvinceName string `json:"province_name"` // 省份名称
+	CityCode     string `json:"city_code"`     // 城市编码
+	CityName     string `json:"city_name"`     // 城市名称
+	DistrictCode string `json:"district_code"` // 区县编码
+	DistrictName string `json:"district_name"` // 区县名称
+}
+
+// AddressParseResponse 地址解析响应
+type AddressParseResponse struct {
+	AddressRegion
+	Detail     string          `json:"detail"`     // 详细地址
+	Confidence float64         `json:"confidence"` // 置信度，取值 0~1
+	Candidates []AddressRegion `json:"candidates"` // 区县名称有歧义时的候选项
+}
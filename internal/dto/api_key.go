@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// APIKeyCreateRequest 创建 API Key 请求
+type APIKeyCreateRequest struct {
+	Name      string        // 名称，一般为合作方名称
+	Scopes    []string      // 授权范围，* 表示全部
+	ExpiresIn time.Duration // 有效期，为 0 时不过期
+}
+
+// APIKeyCreateResponse 创建 API Key 响应，secret 只在创建时返回一次
+type APIKeyCreateResponse struct {
+	KeyID     string `json:"key_id"`     // 公开的 key 标识
+	Secret    string `json:"secret"`     // 签名使用的 secret
+	Scopes    string `json:"scopes"`     // 授权范围
+	ExpiresAt string `json:"expires_at"` // 过期时间，为空时不过期
+}
@@ -0,0 +1,27 @@
+package dto
+
+// AreaSearchRequest 省市区搜索请求
+type AreaSearchRequest struct {
+	Keyword string `form:"keyword" binding:"required,max=32" normalize:"trim,halfwidth"` // 关键词，支持汉字、全拼、首字母及混合输入
+	Limit   int    `form:"limit" binding:"omitempty,min=1,max=50"`                       // 返回数量，默认20
+}
+
+// AreaSearchItem 省市区搜索结果
+type AreaSearchItem struct {
+	AddressRegion
+	Code   string `json:"code"`   // 命中的区划编码
+	Name   string `json:"name"`   // 命中的区划名称
+	Level  int    `json:"level"`  // 级别：1省 2市 3区县
+	Pinyin string `json:"pinyin"` // 拼音，音节之间以空格分隔
+}
+
+// AreaSearchResponse 省市区搜索响应
+type AreaSearchResponse struct {
+	List []AreaSearchItem `json:"list"` // 按匹配度、级别排序的结果
+}
+
+// AreaVersionResponse 省市区数据版本响应
+type AreaVersionResponse struct {
+	Version    string            `json:"version"`    // 当前生效的数据版本
+	Successors map[string]string `json:"successors"` // 已撤销的区划编码 -> 继任编码
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/text/language"
+)
+
+// FieldError 单个字段的校验错误
+type FieldError struct {
+	Field    string `json:"field"`     // 结构体字段路径，如 Address.CityCode
+	JSONName string `json:"json_name"` // 客户端提交的参数名路径，如 address.city_code
+	Rule     string `json:"rule"`      // 未通过的校验规则，如 required
+	Message  string `json:"message"`   // 本地化后的错误提示
+}
+
+// 支持的错误提示语言，第一个为默认语言
+var localeMatcher = language.NewMatcher([]language.Tag{language.Chinese, language.English})
+
+// Locale 根据 Accept-Language 请求头选择错误提示语言，返回 zh 或 en，无法匹配时返回 zh
+func Locale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return "zh"
+	}
+	tag, _, confidence := localeMatcher.Match(tags...)
+	if confidence == language.No {
+		return "zh"
+	}
+	base, _ := tag.Base()
+	return base.String()
+}
+
+// FieldErrors 把校验错误转换为逐个字段的错误列表，err 不是校验错误时返回 nil
+func FieldErrors(err error, locale string) []FieldError {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+
+	trans := Translator(locale)
+	fields := make([]FieldError, 0, len(errs))
+	for _, fe := range errs {
+		fields = append(fields, FieldError{
+			Field:    trimRoot(fe.StructNamespace()),
+			JSONName: trimRoot(fe.Namespace()),
+			Rule:     fe.Tag(),
+			Message:  fe.Translate(trans),
+		})
+	}
+	return fields
+}
+
+// trimRoot 去掉命名空间中的顶层结构体名称，如 UserCreateRequest.email -> email
+func trimRoot(namespace string) string {
+	if _, rest, ok := strings.Cut(namespace, "."); ok {
+		return rest
+	}
+	return namespace
+}
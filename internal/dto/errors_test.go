@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "未指定", acceptLanguage: "", expected: "zh"},
+		{name: "简体中文", acceptLanguage: "zh-CN,zh;q=0.9", expected: "zh"},
+		{name: "英文", acceptLanguage: "en-US,en;q=0.9", expected: "en"},
+		{name: "按权重选择", acceptLanguage: "en;q=0.5, zh;q=0.8", expected: "zh"},
+		{name: "不支持的语言", acceptLanguage: "fr-FR", expected: "zh"},
+		{name: "格式错误", acceptLanguage: ";;;", expected: "zh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Locale(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	v := newTestValidator()
+
+	type request struct {
+		Email   string  `json:"email" binding:"required,email"`
+		Address Address `json:"address"`
+	}
+	err := v.Struct(request{Email: "bad", Address: Address{ProvinceCode: "32", CityCode: "3301", DistrictCode: "330106"}})
+
+	tests := []struct {
+		locale   string
+		expected []FieldError
+	}{
+		{
+			locale: "zh",
+			expected: []FieldError{
+				{Field: "Email", JSONName: "email", Rule: "email", Message: "email必须是一个有效的邮箱"},
+				{Field: "Address.CityCode", JSONName: "address.city_code", Rule: "city_in_province", Message: "city_code不属于所选省份"},
+			},
+		},
+		{
+			locale: "en",
+			expected: []FieldError{
+				{Field: "Email", JSONName: "email", Rule: "email", Message: "email must be a valid email address"},
+				{Field: "Address.CityCode", JSONName: "address.city_code", Rule: "city_in_province", Message: "city_code does not belong to the selected province"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FieldErrors(err, tt.locale))
+		})
+	}
+
+	assert.Nil(t, FieldErrors(errors.New("unexpected EOF"), "zh"))
+	assert.Nil(t, FieldErrors(nil, "zh"))
+}
@@ -0,0 +1,180 @@
+package dto
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gin-gonic/gin/binding"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// normalizers normalize tag 支持的规则，按 tag 中的顺序依次执行
+//
+//   - trim：去掉首尾空白
+//   - lower：转为小写
+//   - nfkc：Unicode NFKC 规范化，兼容字符转为标准形式，如"①"->"1"、"ｆｉ"->"fi"
+//   - halfwidth：全角字符转为半角，如中文输入法下输入的"１２３"、"ＡＢＣ"、全角空格
+//   - collapse_space：连续的空白合并为一个空格
+var normalizers = map[string]func(string) string{
+	"trim":           strings.TrimSpace,
+	"lower":          strings.ToLower,
+	"nfkc":           norm.NFKC.String,
+	"halfwidth":      width.Narrow.String,
+	"collapse_space": collapseSpace,
+}
+
+// collapseSpace 把连续的空白字符合并为一个空格
+func collapseSpace(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !space {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// normalizeField 结构体中需要处理的一个字段
+type normalizeField struct {
+	index int
+	rules []func(string) string // 带 normalize tag 的字符串、字符串指针或字符串切片字段
+}
+
+// normalizeFields 缓存每个结构体类型需要处理的字段
+var normalizeFields sync.Map // reflect.Type -> []normalizeField
+
+// Normalize 按 normalize tag 规范化 obj 中的字符串字段，obj 需要是结构体指针，嵌套的结构体及结构体切片会递归处理
+//
+// 使用方式：
+//
+//	Keyword string `form:"keyword" normalize:"trim,halfwidth,collapse_space"`
+//
+// tag 中包含不支持的规则时 panic，与 validator 对未注册规则的处理方式一致
+func Normalize(obj interface{}) {
+	normalizeValue(reflect.ValueOf(obj))
+}
+
+func normalizeValue(v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for _, f := range structNormalizeFields(v.Type()) {
+			if f.rules != nil {
+				applyRules(v.Field(f.index), f.rules)
+			} else {
+				normalizeValue(v.Field(f.index))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeValue(v.Index(i))
+		}
+	}
+}
+
+// applyRules 对字符串、字符串指针及字符串切片执行规则，不可修改的值直接忽略
+func applyRules(v reflect.Value, rules []func(string) string) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if !v.CanSet() {
+			return
+		}
+		s := v.String()
+		for _, rule := range rules {
+			s = rule(s)
+		}
+		v.SetString(s)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			applyRules(v.Index(i), rules)
+		}
+	}
+}
+
+func structNormalizeFields(typ reflect.Type) []normalizeField {
+	if fields, ok := normalizeFields.Load(typ); ok {
+		return fields.([]normalizeField)
+	}
+
+	var fields []normalizeField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if tag := field.Tag.Get("normalize"); tag != "" {
+			fields = append(fields, normalizeField{index: i, rules: parseNormalizeTag(typ, field, tag)})
+			continue
+		}
+		if mayContainStruct(field.Type) {
+			fields = append(fields, normalizeField{index: i})
+		}
+	}
+
+	normalizeFields.Store(typ, fields)
+	return fields
+}
+
+func parseNormalizeTag(typ reflect.Type, field reflect.StructField, tag string) []func(string) string {
+	var rules []func(string) string
+	for _, name := range strings.Split(tag, ",") {
+		rule, ok := normalizers[strings.TrimSpace(name)]
+		if !ok {
+			panic(fmt.Sprintf("dto: unknown normalize rule %q on %s.%s", name, typ, field.Name))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// mayContainStruct 判断字段是否可能包含需要递归处理的结构体
+func mayContainStruct(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct || typ.Kind() == reflect.Interface
+}
+
+// normalizeValidator 在校验前按 normalize tag 规范化请求参数
+type normalizeValidator struct {
+	binding.StructValidator
+}
+
+// NormalizeValidator 包装 gin 的校验器，gin 在绑定完成后、校验之前调用 ValidateStruct，
+// 此时先执行 Normalize，校验及后续的业务逻辑拿到的都是规范化后的值
+//
+// 使用方式：binding.Validator = dto.NormalizeValidator(binding.Validator)
+func NormalizeValidator(v binding.StructValidator) binding.StructValidator {
+	return normalizeValidator{StructValidator: v}
+}
+
+// ValidateStruct 规范化后再校验
+func (v normalizeValidator) ValidateStruct(obj any) error {
+	Normalize(obj)
+	return v.StructValidator.ValidateStruct(obj)
+}
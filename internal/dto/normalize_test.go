@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	type item struct {
+		Name string `normalize:"trim"`
+	}
+	type request struct {
+		Trim     string   `normalize:"trim"`
+		Lower    string   `normalize:"trim,lower"`
+		NFKC     string   `normalize:"nfkc"`
+		Half     string   `normalize:"halfwidth"`
+		Collapse string   `normalize:"trim,collapse_space"`
+		Raw      string   // 没有 tag 不处理
+		Ptr      *string  `normalize:"trim"`
+		Tags     []string `normalize:"trim,lower"`
+		Item     item
+		Items    []*item
+	}
+
+	ptr := "  p  "
+	got := request{
+		Trim:     "  a  ",
+		Lower:    " ABC ",
+		NFKC:     "①ｆｉ",
+		Half:     "１２３　ＡＢＣ　文三路",
+		Collapse: "  a \t\n b　　c  ",
+		Raw:      "  raw  ",
+		Ptr:      &ptr,
+		Tags:     []string{" Go ", "RUST"},
+		Item:     item{Name: " x "},
+		Items:    []*item{{Name: " y "}, nil},
+	}
+	Normalize(&got)
+
+	assert.Equal(t, "a", got.Trim)
+	assert.Equal(t, "abc", got.Lower)
+	assert.Equal(t, "1fi", got.NFKC)
+	assert.Equal(t, "123 ABC 文三路", got.Half)
+	assert.Equal(t, "a b c", got.Collapse)
+	assert.Equal(t, "  raw  ", got.Raw)
+	assert.Equal(t, "p", *got.Ptr)
+	assert.Equal(t, []string{"go", "rust"}, got.Tags)
+	assert.Equal(t, "x", got.Item.Name)
+	assert.Equal(t, "y", got.Items[0].Name)
+	assert.Nil(t, got.Items[1])
+}
+
+func TestNormalizeUnknownRule(t *testing.T) {
+	type request struct {
+		Name string `normalize:"trim,upper"`
+	}
+	assert.Panics(t, func() { Normalize(&request{}) })
+}
+
+func TestNormalizeValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	old := binding.Validator
+	binding.Validator = NormalizeValidator(old)
+	defer func() { binding.Validator = old }()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expected       UserCreateRequest
+	}{
+		{
+			name:           "全角输入规范化后通过校验",
+			body:           `{"username":" ｔｏｍ１ ","password":" pwd ","email":" ＴＯＭ＠Example.com "}`,
+			expectedStatus: http.StatusOK,
+			expected:       UserCreateRequest{Username: "tom1", Password: " pwd ", Email: "tom@example.com"},
+		},
+		{
+			name:           "规范化后为空时校验失败",
+			body:           `{"username":"　","password":"pwd","email":"tom@example.com"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			var got UserCreateRequest
+			router.POST("/users", func(c *gin.Context) {
+				if err := c.ShouldBindJSON(&got); err != nil {
+					c.Status(http.StatusBadRequest)
+					return
+				}
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package dto
 
 // UserCreateRequest 创建用户请求
 type UserCreateRequest struct {
-	Username string `json:"username" binding:"required"`    // 用户名
-	Password string `json:"password" binding:"required"`    // 密码
-	Email    string `json:"email" binding:"required,email"` // 邮箱
+	Username string `json:"username" binding:"required" normalize:"trim,nfkc"`          // 用户名，全角字母数字转为半角
+	Password string `json:"password" binding:"required" trim:"-"`                       // 密码，首尾空格视为密码的一部分
+	Email    string `json:"email" binding:"required,email" normalize:"trim,nfkc,lower"` // 邮箱
 }
 
 // UserCreateResponse 创建用户响应
@@ -17,9 +17,9 @@ type UserCreateResponse struct {
 
 // UserListRequest 用户列表请求
 type UserListRequest struct {
-	Page     int    `form:"page" binding:"required,min=1"`      // 页码
-	PageSize int    `form:"page_size" binding:"required,min=1"` // 每页数量
-	Keyword  string `form:"keyword"`                            // 搜索关键词
+	Page     int    `form:"page" binding:"required,min=1"`                // 页码
+	PageSize int    `form:"page_size" binding:"required,min=1"`           // 每页数量
+	Keyword  string `form:"keyword" normalize:"trim,nfkc,collapse_space"` // 搜索关键词
 }
 
 // UserListResponse 用户列表响应
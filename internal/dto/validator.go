@@ -1,14 +1,96 @@
 package dto
 
 import (
+	"reflect"
+	"strings"
 	"time"
 
+	"godemo/internal/area"
+	"godemo/internal/idcard"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/zh"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
 )
 
+// uni 校验错误提示的多语言翻译器，默认中文
+var uni = ut.New(zh.New(), zh.New(), en.New())
+
+// customMessages 自定义校验规则的错误提示，{0} 为字段名
+var customMessages = map[string]map[string]string{
+	"zh": {
+		"year":             "{0}不是有效的年份",
+		"province_code":    "{0}不是有效的省份编码",
+		"city_code":        "{0}不是有效的城市编码",
+		"district_code":    "{0}不是有效的区县编码",
+		"city_in_province": "{0}不属于所选省份",
+		"district_in_city": "{0}不属于所选城市",
+		"idcard":           "{0}不是有效的身份证号",
+	},
+	"en": {
+		"year":             "{0} must be a valid year",
+		"province_code":    "{0} must be a valid province code",
+		"city_code":        "{0} must be a valid city code",
+		"district_code":    "{0} must be a valid district code",
+		"city_in_province": "{0} does not belong to the selected province",
+		"district_in_city": "{0} does not belong to the selected city",
+		"idcard":           "{0} must be a valid resident ID number",
+	},
+}
+
 // RegisterValidator 注册验证器
 func RegisterValidator(validate *validator.Validate) {
+	validate.RegisterTagNameFunc(fieldName)
 	validate.RegisterValidation("year", ValidYear)
+	validate.RegisterValidation("province_code", ValidProvinceCode)
+	validate.RegisterValidation("city_code", ValidCityCode)
+	validate.RegisterValidation("district_code", ValidDistrictCode)
+	validate.RegisterValidation("idcard", ValidIDCard)
+	validate.RegisterStructValidation(ValidAddress, Address{})
+	registerTranslations(validate)
+}
+
+// Translator 获取指定语言的翻译器，不支持的语言返回中文翻译器
+//
+// 使用方式：validator.ValidationErrors.Translate(dto.Translator("en"))
+func Translator(locale string) ut.Translator {
+	trans, _ := uni.GetTranslator(locale)
+	return trans
+}
+
+// fieldName 校验错误中使用 json 或 form tag 中的名称作为字段名，与客户端提交的参数名保持一致
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// registerTranslations 注册内置及自定义校验规则的多语言错误提示
+func registerTranslations(validate *validator.Validate) {
+	zh_translations.RegisterDefaultTranslations(validate, Translator("zh"))
+	en_translations.RegisterDefaultTranslations(validate, Translator("en"))
+
+	for locale, messages := range customMessages {
+		trans := Translator(locale)
+		for tag, message := range messages {
+			validate.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+				return ut.Add(tag, message, true)
+			}, func(ut ut.Translator, fe validator.FieldError) string {
+				msg, err := ut.T(fe.Tag(), fe.Field())
+				if err != nil {
+					return fe.Error()
+				}
+				return msg
+			})
+		}
+	}
 }
 
 // ValidYear 验证年份
@@ -17,3 +99,41 @@ func ValidYear(fl validator.FieldLevel) bool {
 	currentYear := int64(time.Now().Year())
 	return year >= 1900 && year <= currentYear+100 // 可根据需要设定合理年份范围
 }
+
+// ValidProvinceCode 验证2位省份编码
+func ValidProvinceCode(fl validator.FieldLevel) bool {
+	_, ok := area.Current().Areas.Province(fl.Field().String())
+	return ok
+}
+
+// ValidCityCode 验证4位城市编码
+func ValidCityCode(fl validator.FieldLevel) bool {
+	_, ok := area.Current().Areas.City(fl.Field().String())
+	return ok
+}
+
+// ValidDistrictCode 验证6位区县编码
+func ValidDistrictCode(fl validator.FieldLevel) bool {
+	_, ok := area.Current().Areas.District(fl.Field().String())
+	return ok
+}
+
+// ValidIDCard 验证18位居民身份证号的校验码和出生日期
+func ValidIDCard(fl validator.FieldLevel) bool {
+	return idcard.Validate(fl.Field().String()) == nil
+}
+
+// ValidAddress 校验省市区之间的归属关系
+//
+// 编码本身是否有效由字段级校验负责，这里只在编码有效时检查归属，避免同一字段重复报错
+func ValidAddress(sl validator.StructLevel) {
+	addr := sl.Current().Interface().(Address)
+	areas := area.Current().Areas
+
+	if _, ok := areas.City(addr.CityCode); ok && !strings.HasPrefix(addr.CityCode, addr.ProvinceCode) {
+		sl.ReportError(addr.CityCode, "city_code", "CityCode", "city_in_province", "")
+	}
+	if _, ok := areas.District(addr.DistrictCode); ok && !strings.HasPrefix(addr.DistrictCode, addr.CityCode) {
+		sl.ReportError(addr.DistrictCode, "district_code", "DistrictCode", "district_in_city", "")
+	}
+}
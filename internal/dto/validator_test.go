@@ -0,0 +1,129 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestValidator 创建与 gin 相同 tag 的校验器
+func newTestValidator() *validator.Validate {
+	v := validator.New()
+	v.SetTagName("binding")
+	RegisterValidator(v)
+	return v
+}
+
+func TestValidAddress(t *testing.T) {
+	v := newTestValidator()
+
+	tests := []struct {
+		name    string
+		address Address
+		errTags map[string]string // 字段名 -> 校验规则
+	}{
+		{
+			name:    "合法地址",
+			address: Address{ProvinceCode: "33", CityCode: "3301", DistrictCode: "330106", Detail: "文三路100号"},
+		},
+		{
+			name:    "河南洛阳之后的城市",
+			address: Address{ProvinceCode: "41", CityCode: "4113", DistrictCode: "411302"},
+		},
+		{
+			name:    "无效省份编码",
+			address: Address{ProvinceCode: "99", CityCode: "3301", DistrictCode: "330106"},
+			errTags: map[string]string{"ProvinceCode": "province_code", "CityCode": "city_in_province"},
+		},
+		{
+			name:    "无效城市和区县编码",
+			address: Address{ProvinceCode: "33", CityCode: "3399", DistrictCode: "339901"},
+			errTags: map[string]string{"CityCode": "city_code", "DistrictCode": "district_code"},
+		},
+		{
+			name:    "城市不属于省份",
+			address: Address{ProvinceCode: "32", CityCode: "3301", DistrictCode: "330106"},
+			errTags: map[string]string{"CityCode": "city_in_province"},
+		},
+		{
+			name:    "区县不属于城市",
+			address: Address{ProvinceCode: "33", CityCode: "3301", DistrictCode: "330203"},
+			errTags: map[string]string{"DistrictCode": "district_in_city"},
+		},
+		{
+			name:    "缺少编码",
+			address: Address{},
+			errTags: map[string]string{"ProvinceCode": "required", "CityCode": "required", "DistrictCode": "required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.address)
+			if len(tt.errTags) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs validator.ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			got := make(map[string]string, len(errs))
+			for _, fe := range errs {
+				got[fe.StructField()] = fe.Tag()
+			}
+			assert.Equal(t, tt.errTags, got)
+		})
+	}
+}
+
+func TestTranslator(t *testing.T) {
+	v := newTestValidator()
+	err := v.Struct(Address{ProvinceCode: "32", CityCode: "3301", DistrictCode: "330106"})
+
+	var errs validator.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{locale: "zh", expected: "city_code不属于所选省份"},
+		{locale: "en", expected: "city_code does not belong to the selected province"},
+		{locale: "fr", expected: "city_code不属于所选省份"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			msgs := errs.Translate(Translator(tt.locale))
+			assert.Equal(t, tt.expected, msgs["Address.city_code"])
+		})
+	}
+}
+
+func TestValidIDCard(t *testing.T) {
+	v := newTestValidator()
+
+	type request struct {
+		IDCard string `binding:"omitempty,idcard"`
+	}
+
+	tests := []struct {
+		name   string
+		idCard string
+		valid  bool
+	}{
+		{name: "合法号码", idCard: "11010519491231002X", valid: true},
+		{name: "空值", idCard: "", valid: true},
+		{name: "校验码错误", idCard: "110105194912310021"},
+		{name: "出生日期无效", idCard: "110105194913310026"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(request{IDCard: tt.idCard})
+			assert.Equal(t, tt.valid, err == nil)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Package flags 功能开关，开关定义在 BusinessConfig 的 [business.features] 中，热更新后立即生效
+//
+//	if flags.Enabled(ctx, "new_search") {
+//		...
+//	}
+package flags
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"godemo/config"
+	"godemo/internal/constants"
+
+	"github.com/jessewkun/gocommon/constant"
+)
+
+// Enabled 判断功能开关对 ctx 所属的请求是否打开，开关不存在时返回 false
+//
+// 用户 ID 及设备 ID 从 ctx 中获取，分别由登录及 ClientVersion 中间件设置
+func Enabled(ctx context.Context, name string) bool {
+	flag, ok := config.BusinessCfg.FeatureFlag(name)
+	if !ok {
+		return false
+	}
+	return evaluate(name, flag, valueOf(ctx, constant.CtxUserID), valueOf(ctx, constants.CtxDeviceID))
+}
+
+// evaluate 按 FeatureFlag 的规则计算开关结果
+func evaluate(name string, flag config.FeatureFlag, userID, deviceID string) bool {
+	if !flag.Enabled {
+		return false
+	}
+	if userID != "" && contains(flag.UserIDs, userID) {
+		return true
+	}
+	if deviceID != "" && contains(flag.Allowlist, deviceID) {
+		return true
+	}
+	if flag.Percentage <= 0 {
+		return false
+	}
+	if flag.Percentage >= 100 {
+		return true
+	}
+
+	// 按用户分桶，未登录时按设备分桶，都没有时无法保证结果稳定，不打开
+	subject := userID
+	if subject == "" {
+		subject = deviceID
+	}
+	if subject == "" {
+		return false
+	}
+	return bucket(name, subject) < flag.Percentage
+}
+
+// bucket 把开关名称及用户映射到 0~99，同一用户在不同开关中的分桶相互独立
+func bucket(name, subject string) int {
+	h := fnv.New32a()
+	h.Write([]byte(name + ":" + subject))
+	return int(h.Sum32() % 100)
+}
+
+func valueOf(ctx context.Context, key constant.ContextKey) string {
+	if value := ctx.Value(key); value != nil {
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package flags
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"godemo/config"
+	"godemo/internal/constants"
+
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		flag     config.FeatureFlag
+		userID   string
+		deviceID string
+		want     bool
+	}{
+		{name: "关闭", flag: config.FeatureFlag{Enabled: false, UserIDs: []string{"1"}}, userID: "1", want: false},
+		{name: "灰度为 0", flag: config.FeatureFlag{Enabled: true}, userID: "1", want: false},
+		{name: "用户名单内", flag: config.FeatureFlag{Enabled: true, UserIDs: []string{"1"}}, userID: "1", want: true},
+		{name: "用户名单外", flag: config.FeatureFlag{Enabled: true, UserIDs: []string{"1"}}, userID: "2", want: false},
+		{name: "设备名单内", flag: config.FeatureFlag{Enabled: true, Allowlist: []string{"d1"}}, deviceID: "d1", want: true},
+		{name: "全量灰度", flag: config.FeatureFlag{Enabled: true, Percentage: 100}, want: true},
+		{name: "名单外且灰度为 0", flag: config.FeatureFlag{Enabled: true, Allowlist: []string{"d1"}}, deviceID: "d2", want: false},
+		{name: "灰度但无法分桶", flag: config.FeatureFlag{Enabled: true, Percentage: 50}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, evaluate("demo", tt.flag, tt.userID, tt.deviceID))
+		})
+	}
+}
+
+func TestEvaluatePercentage(t *testing.T) {
+	flag := config.FeatureFlag{Enabled: true, Percentage: 30}
+	enabled := 0
+	for i := 0; i < 10000; i++ {
+		userID := strconv.Itoa(i)
+		got := evaluate("demo", flag, userID, "")
+		// 同一用户的结果稳定
+		assert.Equal(t, got, evaluate("demo", flag, userID, ""))
+		if got {
+			enabled++
+		}
+	}
+	assert.InDelta(t, 3000, enabled, 300)
+}
+
+func TestEnabled(t *testing.T) {
+	old := config.BusinessCfg.Features
+	t.Cleanup(func() { config.BusinessCfg.Features = old })
+	config.BusinessCfg.Features = map[string]config.FeatureFlag{
+		"beta": {Enabled: true, UserIDs: []string{"7"}, Allowlist: []string{"tester"}},
+	}
+
+	ctx := context.Background()
+	assert.False(t, Enabled(ctx, "beta"))
+	assert.False(t, Enabled(ctx, "unknown"))
+	assert.True(t, Enabled(context.WithValue(ctx, constant.CtxUserID, 7), "beta"))
+	assert.True(t, Enabled(context.WithValue(ctx, constants.CtxDeviceID, "tester"), "beta"))
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
+	"godemo/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/response"
+)
+
+type AddressHandler struct {
+	addressService *service.AddressService
+}
+
+func NewAddressHandler(addressService *service.AddressService) *AddressHandler {
+	return &AddressHandler{
+		addressService: addressService,
+	}
+}
+
+// Parse 解析地址
+func (h *AddressHandler) Parse(c *gin.Context) {
+	var req dto.AddressParseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperr.Render(c, bindError(c, err))
+		return
+	}
+
+	resp, err := h.addressService.Parse(c.Request.Context(), &req)
+	if err != nil {
+		apperr.Render(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
+	"godemo/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/response"
+)
+
+type AreaHandler struct {
+	areaService *service.AreaService
+}
+
+func NewAreaHandler(areaService *service.AreaService) *AreaHandler {
+	return &AreaHandler{
+		areaService: areaService,
+	}
+}
+
+// Search 按拼音或首字母搜索省市区
+func (h *AreaHandler) Search(c *gin.Context) {
+	var req dto.AreaSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperr.Render(c, bindError(c, err))
+		return
+	}
+
+	resp, err := h.areaService.Search(c.Request.Context(), &req)
+	if err != nil {
+		apperr.Render(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// Version 获取当前生效的省市区数据版本
+func (h *AreaHandler) Version(c *gin.Context) {
+	resp, err := h.areaService.Version(c.Request.Context())
+	if err != nil {
+		apperr.Render(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
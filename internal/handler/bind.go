@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindError 把参数绑定失败的错误转换为参数错误
+//
+// 校验失败时按 Accept-Language 返回逐个字段的错误提示，data 为 {"errors": [...]}，message 为第一个字段的提示；
+// JSON 格式错误等其他情况保留原始提示
+func bindError(c *gin.Context, err error) error {
+	fields := dto.FieldErrors(err, dto.Locale(c.GetHeader("Accept-Language")))
+	if len(fields) == 0 {
+		return apperr.BindError(err)
+	}
+	return apperr.ErrValidation.
+		WithMessage(fields[0].Message).
+		WithData(gin.H{"errors": fields}).
+		Wrap(err)
+}
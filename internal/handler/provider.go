@@ -4,4 +4,6 @@ import "github.com/google/wire"
 
 var ProviderSet = wire.NewSet(
 	NewUserHandler,
+	NewAddressHandler,
+	NewAreaHandler,
 )
@@ -1,12 +1,12 @@
 package handler
 
 import (
-	"net/http"
-
+	"godemo/internal/apperr"
 	"godemo/internal/dto"
 	"godemo/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/response"
 )
 
 type UserHandler struct {
@@ -23,32 +23,32 @@ func NewUserHandler(userService *service.UserService) *UserHandler {
 func (h *UserHandler) Create(c *gin.Context) {
 	var req dto.UserCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apperr.Render(c, bindError(c, err))
 		return
 	}
 
 	resp, err := h.userService.Create(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		apperr.Render(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, resp)
+	response.Success(c, resp)
 }
 
 // List 获取用户列表
 func (h *UserHandler) List(c *gin.Context) {
 	var req dto.UserListRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apperr.Render(c, bindError(c, err))
 		return
 	}
 
 	resp, err := h.userService.List(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		apperr.Render(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, resp)
+	response.Success(c, resp)
 }
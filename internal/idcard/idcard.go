@@ -0,0 +1,128 @@
+// Package idcard 提供居民身份证号码（GB 11643-1999）的校验、解析和脱敏
+package idcard
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"godemo/internal/area"
+
+	"github.com/jessewkun/gocommon/utils"
+)
+
+// Gender 性别
+type Gender string
+
+const (
+	GenderMale   Gender = "male"   // 男
+	GenderFemale Gender = "female" // 女
+)
+
+var (
+	ErrInvalidFormat   = errors.New("身份证号格式错误")
+	ErrInvalidBirthday = errors.New("身份证号出生日期无效")
+	ErrInvalidChecksum = errors.New("身份证号校验码错误")
+)
+
+// 前17位的加权因子
+var weights = [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+// 加权和对11取模后对应的校验码
+const checkCodes = "10X98765432"
+
+// 最早允许的出生日期
+var minBirthday = time.Date(1900, 1, 1, 0, 0, 0, 0, time.Local)
+
+var (
+	formatRegexp = regexp.MustCompile(`^\d{17}[\dX]$`)
+	// 文本中疑似身份证号的片段，用于日志脱敏
+	textRegexp = regexp.MustCompile(`\b\d{17}[\dXx]\b`)
+)
+
+// Info 身份证号解析结果
+type Info struct {
+	Number   string      // 身份证号，末位校验码统一为大写
+	Gender   Gender      // 性别
+	Birthday time.Time   // 出生日期
+	Region   area.Region // 发证地，编码已撤销时只包含能识别出的上级区划
+}
+
+// Validate 校验18位身份证号的格式、出生日期和校验码
+func Validate(number string) error {
+	_, err := parse(normalize(number))
+	return err
+}
+
+// Parse 解析身份证号，返回性别、出生日期和发证地
+func Parse(number string) (*Info, error) {
+	number = normalize(number)
+	birthday, err := parse(number)
+	if err != nil {
+		return nil, err
+	}
+
+	gender := GenderFemale
+	if (number[16]-'0')%2 == 1 {
+		gender = GenderMale
+	}
+	region, _ := area.LookupRegion(number[:6])
+
+	return &Info{
+		Number:   number,
+		Gender:   gender,
+		Birthday: birthday,
+		Region:   region,
+	}, nil
+}
+
+// Mask 身份证号脱敏，保留前4位和后4位，用于接口返回和日志
+func Mask(number string) string {
+	number = strings.TrimSpace(number)
+	if len(number) <= 8 {
+		return strings.Repeat("*", len(number))
+	}
+	return utils.MaskCustome(number, 4, len(number)-4)
+}
+
+// MaskText 对文本中所有合法的身份证号脱敏，用于记录包含身份证号的日志
+func MaskText(text string) string {
+	return textRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		if Validate(s) != nil {
+			return s
+		}
+		return Mask(s)
+	})
+}
+
+// Checksum 根据前17位计算校验码
+func Checksum(first17 string) byte {
+	sum := 0
+	for i := 0; i < 17; i++ {
+		sum += int(first17[i]-'0') * weights[i]
+	}
+	return checkCodes[sum%11]
+}
+
+// normalize 去掉首尾空白并把末位的 x 转为大写
+func normalize(number string) string {
+	return strings.ToUpper(strings.TrimSpace(number))
+}
+
+// parse 校验身份证号并返回出生日期
+func parse(number string) (time.Time, error) {
+	if !formatRegexp.MatchString(number) {
+		return time.Time{}, ErrInvalidFormat
+	}
+
+	birthday, err := time.ParseInLocation("20060102", number[6:14], time.Local)
+	if err != nil || birthday.Before(minBirthday) || birthday.After(time.Now()) {
+		return time.Time{}, ErrInvalidBirthday
+	}
+
+	if Checksum(number[:17]) != number[17] {
+		return time.Time{}, ErrInvalidChecksum
+	}
+	return birthday, nil
+}
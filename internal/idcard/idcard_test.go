@@ -0,0 +1,92 @@
+package idcard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   string
+		expected error
+	}{
+		{name: "合法号码", number: "330106199001011248"},
+		{name: "校验码为X", number: "11010519491231002X"},
+		{name: "校验码为小写x", number: "11010519491231002x"},
+		{name: "首尾空格", number: " 330106199001011248 "},
+		{name: "闰年2月29日", number: "411302200002290033"},
+		{name: "长度不足", number: "33010619900101124", expected: ErrInvalidFormat},
+		{name: "包含非法字符", number: "33010619900101124A", expected: ErrInvalidFormat},
+		{name: "15位旧号码", number: "330106900101124", expected: ErrInvalidFormat},
+		{name: "日期不存在", number: "330106199002301240", expected: ErrInvalidBirthday},
+		{name: "早于1900年", number: "330106189901011240", expected: ErrInvalidBirthday},
+		{name: "未来日期", number: "330106209901011240", expected: ErrInvalidBirthday},
+		{name: "校验码错误", number: "330106199001011249", expected: ErrInvalidChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Validate(tt.number))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("男性", func(t *testing.T) {
+		info, err := Parse("33010619900101123x")
+		require.NoError(t, err)
+		assert.Equal(t, "33010619900101123X", info.Number)
+		assert.Equal(t, GenderMale, info.Gender)
+		assert.Equal(t, time.Date(1990, 1, 1, 0, 0, 0, 0, time.Local), info.Birthday)
+		assert.Equal(t, "浙江省", info.Region.ProvinceName)
+		assert.Equal(t, "杭州市", info.Region.CityName)
+		assert.Equal(t, "西湖区", info.Region.DistrictName)
+	})
+
+	t.Run("女性", func(t *testing.T) {
+		info, err := Parse("330106199001011248")
+		require.NoError(t, err)
+		assert.Equal(t, GenderFemale, info.Gender)
+	})
+
+	t.Run("区县编码已撤销", func(t *testing.T) {
+		info, err := Parse("330199199001011233")
+		require.NoError(t, err)
+		assert.Equal(t, "3301", info.Region.CityCode)
+		assert.Empty(t, info.Region.DistrictCode)
+	})
+
+	t.Run("非法号码", func(t *testing.T) {
+		info, err := Parse("330106199001011249")
+		assert.Nil(t, info)
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+	})
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   string
+		expected string
+	}{
+		{name: "18位号码", number: "330106199001011248", expected: "3301**********1248"},
+		{name: "过短", number: "1234", expected: "****"},
+		{name: "空字符串", number: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Mask(tt.number))
+		})
+	}
+}
+
+func TestMaskText(t *testing.T) {
+	text := `{"id_card":"330106199001011248","order_no":"330106199001011249","trace":"12330106199001011248"}`
+	expected := `{"id_card":"3301**********1248","order_no":"330106199001011249","trace":"12330106199001011248"}`
+	assert.Equal(t, expected, MaskText(text))
+}
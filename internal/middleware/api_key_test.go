@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"godemo/internal/apperr"
+	"godemo/internal/model"
+	"godemo/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubAPIKeyVerifier 按 X-Api-Key 返回固定的 API Key，并记录收到的请求
+type stubAPIKeyVerifier struct {
+	keys map[string]*model.APIKey
+	last *service.SignedRequest
+}
+
+func (v *stubAPIKeyVerifier) Verify(ctx context.Context, req *service.SignedRequest) (*model.APIKey, error) {
+	v.last = req
+	if key, ok := v.keys[req.KeyID]; ok {
+		return key, nil
+	}
+	return nil, apperr.ErrSignatureInvalid
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	verifier := &stubAPIKeyVerifier{keys: map[string]*model.APIKey{
+		"ak_reader": {KeyID: "ak_reader", Scopes: "users:read"},
+		"ak_admin":  {KeyID: "ak_admin", Scopes: "*"},
+	}}
+	auth := &APIKeyAuth{verifier: verifier}
+
+	router := gin.New()
+	partner := router.Group("/api/v1/partner", auth.Middleware())
+	partner.GET("/users", RequireScope("users:read"), func(c *gin.Context) {
+		key, _ := CurrentAPIKey(c)
+		c.String(http.StatusOK, key.KeyID)
+	})
+	partner.POST("/users", RequireScope("users:write"), func(c *gin.Context) {
+		// 校验签名后请求体仍然可以读取
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusCreated, string(body))
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		keyID      string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "签名通过", method: http.MethodGet, keyID: "ak_reader", wantStatus: http.StatusOK, wantBody: "ak_reader"},
+		{name: "签名错误", method: http.MethodGet, keyID: "ak_unknown", wantStatus: http.StatusUnauthorized},
+		{name: "缺少授权范围", method: http.MethodPost, keyID: "ak_reader", body: `{}`, wantStatus: http.StatusForbidden},
+		{name: "全部授权范围", method: http.MethodPost, keyID: "ak_admin", body: `{"username":"tom"}`, wantStatus: http.StatusCreated, wantBody: `{"username":"tom"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/v1/partner/users?page=1", strings.NewReader(tt.body))
+			req.Header.Set(APIKeyHeader, tt.keyID)
+			req.Header.Set(TimestampHeader, "1700000000")
+			req.Header.Set(NonceHeader, "n1")
+			req.Header.Set(SignatureHeader, "sig")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+			assert.Equal(t, "/api/v1/partner/users", verifier.last.Path)
+			assert.Equal(t, "page=1", verifier.last.RawQuery)
+			assert.Equal(t, tt.body, string(verifier.last.Body))
+		})
+	}
+}
+
+func TestAPIKeyAuthAfterTrim(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	verifier := &stubAPIKeyVerifier{keys: map[string]*model.APIKey{"ak_admin": {KeyID: "ak_admin", Scopes: "*"}}}
+	auth := &APIKeyAuth{verifier: verifier}
+
+	router := gin.New()
+	router.Use(TrimMiddleware(nil))
+	router.POST("/api/v1/partner/users", auth.Middleware(), func(c *gin.Context) {
+		var req struct {
+			Nickname string `json:"nickname" form:"nickname"`
+		}
+		_ = c.ShouldBind(&req)
+		c.String(http.StatusOK, req.Nickname+"|"+c.Query("q"))
+	})
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{name: "JSON 请求体", contentType: "application/json", body: `{"nickname":" bob "}`},
+		{name: "urlencoded 请求体", contentType: gin.MIMEPOSTForm, body: "nickname=+bob+"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/partner/users?q=%20x%20", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set(APIKeyHeader, "ak_admin")
+			req.Header.Set(SignatureHeader, "sig")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// 签名校验使用客户端发送的原始内容，handler 拿到的是 trim 后的值
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.body, string(verifier.last.Body))
+			assert.Equal(t, "q=%20x%20", verifier.last.RawQuery)
+			assert.Equal(t, "bob|x", w.Body.String())
+		})
+	}
+}
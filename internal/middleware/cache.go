@@ -0,0 +1,304 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"godemo/internal/wire/provider"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/jessewkun/gocommon/response"
+)
+
+// CacheStatusHeader 响应是否来自缓存，取值 HIT、MISS、BYPASS
+const CacheStatusHeader = "X-Cache"
+
+// CacheRule 一个路由的缓存规则
+type CacheRule struct {
+	// TTL 缓存时间，响应的 Cache-Control 中 max-age 更小时使用 max-age
+	TTL time.Duration
+	// Tags 缓存所属的标签，通过 Purge 按标签清除
+	Tags []string
+	// VaryHeaders 影响响应内容的请求头，不同取值分别缓存，如 Accept-Language
+	VaryHeaders []string
+}
+
+// cacheRecord 保存在 Redis 中的响应
+//
+// 统一响应格式的接口只保存 code、message、data，命中时使用当前请求的 trace_id 重新输出
+type cacheRecord struct {
+	StoredAt    int64           `json:"stored_at"`
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type,omitempty"`
+	Body        []byte          `json:"body,omitempty"`
+	API         bool            `json:"api,omitempty"`
+	Code        int             `json:"code,omitempty"`
+	Message     string          `json:"message,omitempty"`
+	Data        json.RawMessage `json:"data,omitempty"`
+}
+
+// ResponseCache 基于 Redis 的 GET 接口响应缓存
+//
+// 缓存 key 由路由、规范化后的 query、VaryHeaders 及调用方组成，不同用户的响应互不可见，
+// 未认证的请求共享同一份缓存。
+// 每个标签对应一个版本号，缓存 key 中带有所属标签的版本号，Purge 递增版本号使旧缓存失效，
+// 清除前已开始处理的请求写入的缓存同样不会被读到。Redis 不可用时不使用缓存
+type ResponseCache struct {
+	cache redis.UniversalClient
+	now   func() time.Time
+}
+
+// NewResponseCache 创建使用 MainCache 的 ResponseCache
+func NewResponseCache(cache provider.MainCache) *ResponseCache {
+	return newResponseCache(cache.UniversalClient)
+}
+
+func newResponseCache(cache redis.UniversalClient) *ResponseCache {
+	return &ResponseCache{cache: cache, now: time.Now}
+}
+
+// Cache 按规则缓存 GET 请求的 200 响应
+//
+// 请求带有 Cache-Control: no-store 时不读写缓存，no-cache 或 max-age=0 时不读缓存但更新缓存；
+// 响应带有 Cache-Control: no-store、no-cache、private 或 Set-Cookie 头时不缓存
+func (m *ResponseCache) Cache(rule CacheRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet || rule.TTL <= 0 {
+			c.Next()
+			return
+		}
+		directives := parseCacheControl(c.GetHeader("Cache-Control"))
+		if _, ok := directives["no-store"]; ok {
+			c.Header(CacheStatusHeader, "BYPASS")
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		key, err := m.key(ctx, c, rule)
+		if err != nil {
+			logger.Error(ctx, "RESPONSE_CACHE", fmt.Errorf("build response cache key failed: %w", err))
+			c.Next()
+			return
+		}
+
+		if !revalidate(directives) {
+			record, err := m.load(ctx, key)
+			if err != nil {
+				logger.Error(ctx, "RESPONSE_CACHE", fmt.Errorf("load response cache failed, key %s: %w", key, err))
+			}
+			if record != nil {
+				m.replay(c, record)
+				return
+			}
+		}
+
+		c.Header(CacheStatusHeader, "MISS")
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		ttl, ok := responseTTL(c, rule.TTL)
+		if !ok {
+			return
+		}
+		record := &cacheRecord{
+			StoredAt:    m.now().Unix(),
+			Status:      c.Writer.Status(),
+			ContentType: c.Writer.Header().Get("Content-Type"),
+		}
+		if output, ok := c.Get(string(constant.CtxAPIOutput)); ok {
+			if result, ok := output.(*response.APIResult); ok {
+				if result.Code != response.CodeSuccess {
+					return
+				}
+				data, err := json.Marshal(result.Data)
+				if err != nil {
+					return
+				}
+				record.API, record.Code, record.Message, record.Data = true, result.Code, result.Message, data
+			}
+		}
+		if !record.API {
+			record.Body = writer.body.Bytes()
+		}
+		// 保存不受请求超时影响
+		m.save(context.WithoutCancel(ctx), key, record, ttl)
+	}
+}
+
+// PurgeOnSuccess 请求处理成功（2xx）后清除标签下的缓存，用于写接口
+func (m *ResponseCache) PurgeOnSuccess(tags ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if status := c.Writer.Status(); status < http.StatusOK || status >= http.StatusMultipleChoices {
+			return
+		}
+		ctx := context.WithoutCancel(c.Request.Context())
+		if err := m.Purge(ctx, tags...); err != nil {
+			logger.Error(ctx, "RESPONSE_CACHE", fmt.Errorf("purge response cache failed, tags %v: %w", tags, err))
+		}
+	}
+}
+
+// Purge 清除标签下的所有缓存
+func (m *ResponseCache) Purge(ctx context.Context, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	pipe := m.cache.Pipeline()
+	for _, tag := range tags {
+		pipe.Incr(ctx, cacheTagKey(tag))
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// key 生成缓存 key：respcache:<路由>:<调用方>:<标签版本、query 及请求头的摘要>
+func (m *ResponseCache) key(ctx context.Context, c *gin.Context, rule CacheRule) (string, error) {
+	h := sha256.New()
+	if len(rule.Tags) > 0 {
+		tagKeys := make([]string, 0, len(rule.Tags))
+		for _, tag := range rule.Tags {
+			tagKeys = append(tagKeys, cacheTagKey(tag))
+		}
+		versions, err := m.cache.MGet(ctx, tagKeys...).Result()
+		if err != nil {
+			return "", err
+		}
+		for i, version := range versions {
+			fmt.Fprintf(h, "tag %s=%v\n", rule.Tags[i], version)
+		}
+	}
+	h.Write([]byte("query " + normalizeQuery(c.Request.URL.Query()) + "\n"))
+	for _, name := range rule.VaryHeaders {
+		fmt.Fprintf(h, "header %s=%s\n", http.CanonicalHeaderKey(name), strings.Join(c.Request.Header.Values(name), ","))
+	}
+
+	path := c.FullPath()
+	if path == "" {
+		path = c.Request.URL.Path
+	}
+	return "respcache:" + path + ":" + callerScope(c) + ":" + hex.EncodeToString(h.Sum(nil)[:16]), nil
+}
+
+func (m *ResponseCache) load(ctx context.Context, key string) (*cacheRecord, error) {
+	data, err := m.cache.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	record := &cacheRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("decode response cache: %w", err)
+	}
+	return record, nil
+}
+
+func (m *ResponseCache) save(ctx context.Context, key string, record *cacheRecord, ttl time.Duration) {
+	data, _ := json.Marshal(record)
+	if err := m.cache.Set(ctx, key, data, ttl).Err(); err != nil {
+		logger.Error(ctx, "RESPONSE_CACHE", fmt.Errorf("save response cache failed, key %s: %w", key, err))
+	}
+}
+
+// replay 输出缓存的响应，统一响应格式的接口使用当前请求的 trace_id
+func (m *ResponseCache) replay(c *gin.Context, record *cacheRecord) {
+	c.Header(CacheStatusHeader, "HIT")
+	if age := m.now().Unix() - record.StoredAt; age >= 0 {
+		c.Header("Age", strconv.FormatInt(age, 10))
+	}
+	if record.API {
+		c.JSON(record.Status, response.NewAPIResult(c, record.Code, record.Message, record.Data))
+	} else {
+		c.Data(record.Status, record.ContentType, record.Body)
+	}
+	c.Abort()
+}
+
+// responseTTL 根据响应判断是否缓存及缓存时间
+func responseTTL(c *gin.Context, ttl time.Duration) (time.Duration, bool) {
+	if c.Writer.Status() != http.StatusOK {
+		return 0, false
+	}
+	header := c.Writer.Header()
+	if header.Get("Set-Cookie") != "" {
+		return 0, false
+	}
+	directives := parseCacheControl(header.Get("Cache-Control"))
+	for _, d := range []string{"no-store", "no-cache", "private"} {
+		if _, ok := directives[d]; ok {
+			return 0, false
+		}
+	}
+	for _, d := range []string{"s-maxage", "max-age"} {
+		if v, ok := directives[d]; ok {
+			seconds, err := strconv.Atoi(v)
+			if err != nil || seconds <= 0 {
+				return 0, false
+			}
+			if maxAge := time.Duration(seconds) * time.Second; maxAge < ttl {
+				ttl = maxAge
+			}
+			break
+		}
+	}
+	return ttl, true
+}
+
+// revalidate 请求是否要求跳过缓存获取最新的响应
+func revalidate(directives map[string]string) bool {
+	if _, ok := directives["no-cache"]; ok {
+		return true
+	}
+	return directives["max-age"] == "0"
+}
+
+// parseCacheControl 解析 Cache-Control 头，指令名转为小写
+func parseCacheControl(header string) map[string]string {
+	directives := map[string]string{}
+	for _, part := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			directives[name] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return directives
+}
+
+// normalizeQuery 规范化 query，参数按名称排序并去掉空值，参数顺序不同的请求使用同一个缓存
+func normalizeQuery(query url.Values) string {
+	for name, values := range query {
+		kept := values[:0]
+		for _, v := range values {
+			if v != "" {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) == 0 {
+			delete(query, name)
+		} else {
+			query[name] = kept
+		}
+	}
+	return query.Encode()
+}
+
+func cacheTagKey(tag string) string {
+	return "respcache:tag:" + tag
+}
@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/jessewkun/gocommon/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	m := newResponseCache(client)
+
+	calls := 0
+	traceID := 0
+	cacheControl := ""
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		traceID++
+		c.Set(string(constant.CtxTraceID), strconv.Itoa(traceID))
+		if userID := c.GetHeader("X-User-Id"); userID != "" {
+			c.Set(string(constant.CtxUserID), userID)
+		}
+	})
+	rule := CacheRule{TTL: time.Minute, Tags: []string{"users"}, VaryHeaders: []string{"Accept-Language"}}
+	router.GET("/users", m.Cache(rule), func(c *gin.Context) {
+		calls++
+		if cacheControl != "" {
+			c.Header("Cache-Control", cacheControl)
+		}
+		response.Success(c, gin.H{"calls": calls})
+	})
+	router.POST("/users", m.PurgeOnSuccess("users"), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	send := func(method, target string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	result := func(w *httptest.ResponseRecorder) (int, string) {
+		var body struct {
+			Data    struct{ Calls int } `json:"data"`
+			TraceID string              `json:"trace_id"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		return body.Data.Calls, body.TraceID
+	}
+
+	w := send(http.MethodGet, "/users?page=1&keyword=tom", nil)
+	assert.Equal(t, "MISS", w.Header().Get(CacheStatusHeader))
+
+	// query 参数顺序不同、空参数不影响命中，trace_id 使用当前请求的
+	w = send(http.MethodGet, "/users?keyword=tom&page=1&sort=", nil)
+	assert.Equal(t, "HIT", w.Header().Get(CacheStatusHeader))
+	assert.Equal(t, "0", w.Header().Get("Age"))
+	gotCalls, gotTraceID := result(w)
+	assert.Equal(t, 1, gotCalls)
+	assert.Equal(t, strconv.Itoa(traceID), gotTraceID)
+
+	tests := []struct {
+		name       string
+		target     string
+		headers    map[string]string
+		wantStatus string
+	}{
+		{name: "query 不同", target: "/users?page=2&keyword=tom", wantStatus: "MISS"},
+		{name: "Vary 请求头不同", target: "/users?page=1&keyword=tom", headers: map[string]string{"Accept-Language": "en"}, wantStatus: "MISS"},
+		{name: "调用方不同", target: "/users?page=1&keyword=tom", headers: map[string]string{"X-User-Id": "1"}, wantStatus: "MISS"},
+		{name: "no-store 不读写缓存", target: "/users?page=3", headers: map[string]string{"Cache-Control": "no-store"}, wantStatus: "BYPASS"},
+		{name: "no-store 后仍未缓存", target: "/users?page=3", wantStatus: "MISS"},
+		{name: "no-cache 刷新缓存", target: "/users?page=1&keyword=tom", headers: map[string]string{"Cache-Control": "no-cache"}, wantStatus: "MISS"},
+		{name: "刷新后命中", target: "/users?page=1&keyword=tom", wantStatus: "HIT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := send(http.MethodGet, tt.target, tt.headers)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.wantStatus, w.Header().Get(CacheStatusHeader))
+		})
+	}
+
+	// 写接口成功后清除标签下的缓存
+	before := calls
+	assert.Equal(t, http.StatusCreated, send(http.MethodPost, "/users", nil).Code)
+	w = send(http.MethodGet, "/users?page=1&keyword=tom", nil)
+	assert.Equal(t, "MISS", w.Header().Get(CacheStatusHeader))
+	assert.Equal(t, before+1, calls)
+
+	// 响应的 Cache-Control 限制缓存
+	cacheControl = "private"
+	send(http.MethodGet, "/users?page=4", nil)
+	assert.Equal(t, "MISS", send(http.MethodGet, "/users?page=4", nil).Header().Get(CacheStatusHeader))
+
+	cacheControl = "max-age=10"
+	send(http.MethodGet, "/users?page=5", nil)
+	assert.Equal(t, "HIT", send(http.MethodGet, "/users?page=5", nil).Header().Get(CacheStatusHeader))
+	mr.FastForward(11 * time.Second)
+	assert.Equal(t, "MISS", send(http.MethodGet, "/users?page=5", nil).Header().Get(CacheStatusHeader))
+}
+
+func TestResponseCacheNotStored(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	m := newResponseCache(client)
+
+	router := gin.New()
+	rule := CacheRule{TTL: time.Minute}
+	router.GET("/missing", m.Cache(rule), func(c *gin.Context) { c.String(http.StatusNotFound, "missing") })
+	router.GET("/failed", m.Cache(rule), func(c *gin.Context) {
+		c.JSON(http.StatusOK, response.NewAPIResult(c, 10101, "用户不存在", nil))
+	})
+	router.GET("/cookie", m.Cache(rule), func(c *gin.Context) {
+		c.SetCookie("session", "1", 0, "/", "", false, true)
+		c.String(http.StatusOK, "ok")
+	})
+	router.GET("/plain", m.Cache(rule), func(c *gin.Context) { c.String(http.StatusOK, "hello") })
+
+	for _, path := range []string{"/missing", "/failed", "/cookie"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	assert.Empty(t, mr.Keys())
+
+	// 非统一响应格式的接口原样缓存
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/plain", nil))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plain", nil))
+	assert.Equal(t, "HIT", w.Header().Get(CacheStatusHeader))
+	assert.Equal(t, "hello", w.Body.String())
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+}
+
+func TestResponseCacheRedisDown(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	defer client.Close()
+	mr.Close()
+
+	router := gin.New()
+	router.GET("/test", newResponseCache(client).Cache(CacheRule{TTL: time.Minute, Tags: []string{"users"}}), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok", w.Body.String())
+}
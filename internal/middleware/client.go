@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"godemo/config"
+	"godemo/internal/apperr"
+	"godemo/internal/constants"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/constant"
+	"golang.org/x/mod/semver"
+)
+
+// 客户端信息的请求头
+const (
+	DeviceIDHeader      = "did"     // 设备 ID
+	ClientVersionHeader = "version" // 客户端平台及版本，格式为 <平台>/<版本>，如 ios/3.2.1
+
+	// maxDeviceIDLen 设备 ID 的最大长度，超过时忽略，避免异常的请求头写入日志
+	maxDeviceIDLen = 128
+
+	// UpgradeRecommendedHeader 客户端版本低于 deprecated_version 时返回，值为建议升级到的版本
+	UpgradeRecommendedHeader = "X-Upgrade-Recommended"
+)
+
+// ClientInfo 客户端的平台、版本及设备 ID
+type ClientInfo struct {
+	DeviceID string
+	Platform string // 小写，如 ios、android
+	Version  string // 原始版本号，如 3.2.1
+}
+
+// ClientVersion 解析 did、version 请求头，把设备 ID、平台及版本放到 context 中，并按 BusinessConfig 中的配置做版本控制
+//
+// 版本低于平台的 min_version 时返回 426 强制升级，低于 deprecated_version 时在响应头中提示升级。
+// 没有 version 请求头、版本号格式错误或平台未配置时不做限制，如浏览器及服务端调用
+func ClientVersion() gin.HandlerFunc {
+	return clientVersion(config.BusinessCfg.ClientVersionConfig)
+}
+
+func clientVersion(cfg func() config.ClientVersionConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		info := parseClientInfo(c)
+		ctx := c.Request.Context()
+		for key, value := range map[constant.ContextKey]string{
+			constants.CtxDeviceID:      info.DeviceID,
+			constants.CtxPlatform:      info.Platform,
+			constants.CtxClientVersion: info.Version,
+		} {
+			if value != "" {
+				ctx = context.WithValue(ctx, key, value)
+				c.Set(string(key), value)
+			}
+		}
+		c.Request = c.Request.WithContext(ctx)
+
+		rules := cfg()
+		if !rules.Enabled {
+			c.Next()
+			return
+		}
+		rule, ok := rules.Platforms[info.Platform]
+		version, valid := config.CanonicalVersion(info.Version)
+		if !ok || !valid {
+			c.Next()
+			return
+		}
+
+		if minVersion, ok := config.CanonicalVersion(rule.MinVersion); ok && semver.Compare(version, minVersion) < 0 {
+			apperr.Abort(c, apperr.ErrForceUpgrade.WithData(gin.H{
+				"platform":    info.Platform,
+				"min_version": rule.MinVersion,
+				"upgrade_url": rule.UpgradeURL,
+			}))
+			return
+		}
+		if deprecated, ok := config.CanonicalVersion(rule.DeprecatedVersion); ok && semver.Compare(version, deprecated) < 0 {
+			c.Header(UpgradeRecommendedHeader, rule.DeprecatedVersion)
+		}
+		c.Next()
+	}
+}
+
+// CurrentClient 获取 ClientVersion 解析的客户端信息
+func CurrentClient(c *gin.Context) ClientInfo {
+	return ClientInfo{
+		DeviceID: c.GetString(string(constants.CtxDeviceID)),
+		Platform: c.GetString(string(constants.CtxPlatform)),
+		Version:  c.GetString(string(constants.CtxClientVersion)),
+	}
+}
+
+// parseClientInfo 解析客户端信息，version 请求头中没有平台时只有版本号
+func parseClientInfo(c *gin.Context) ClientInfo {
+	info := ClientInfo{DeviceID: strings.TrimSpace(c.GetHeader(DeviceIDHeader))}
+	if len(info.DeviceID) > maxDeviceIDLen {
+		info.DeviceID = ""
+	}
+	value := strings.TrimSpace(c.GetHeader(ClientVersionHeader))
+	if platform, version, ok := strings.Cut(value, "/"); ok {
+		info.Platform = strings.ToLower(strings.TrimSpace(platform))
+		info.Version = strings.TrimSpace(version)
+	} else {
+		info.Version = value
+	}
+	return info
+}
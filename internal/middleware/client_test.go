@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"godemo/config"
+	"godemo/internal/constants"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	cfg := config.ClientVersionConfig{
+		Enabled: true,
+		Platforms: map[string]config.PlatformVersion{
+			"ios":     {MinVersion: "3.0.0", DeprecatedVersion: "3.2", UpgradeURL: "https://example.com/ios"},
+			"android": {MinVersion: "2.5.0"},
+		},
+	}
+	var got ClientInfo
+	var ctxDeviceID interface{}
+	router := gin.New()
+	router.Use(clientVersion(func() config.ClientVersionConfig { return cfg }))
+	router.GET("/test", func(c *gin.Context) {
+		got = CurrentClient(c)
+		ctxDeviceID = c.Request.Context().Value(constants.CtxDeviceID)
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		version        string
+		wantStatus     int
+		wantRecommend  string
+		wantPlatform   string
+		wantAppVersion string
+	}{
+		{name: "最新版本", version: "ios/3.2.1", wantStatus: http.StatusOK, wantPlatform: "ios", wantAppVersion: "3.2.1"},
+		{name: "低于提示升级版本", version: "iOS/3.1.9", wantStatus: http.StatusOK, wantRecommend: "3.2", wantPlatform: "ios", wantAppVersion: "3.1.9"},
+		{name: "等于最低版本", version: "ios/3.0.0", wantStatus: http.StatusOK, wantRecommend: "3.2", wantPlatform: "ios", wantAppVersion: "3.0.0"},
+		{name: "低于最低版本", version: "ios/2.9.10", wantStatus: http.StatusUpgradeRequired},
+		{name: "按数字比较", version: "android/2.10.0", wantStatus: http.StatusOK, wantPlatform: "android", wantAppVersion: "2.10.0"},
+		{name: "带 v 前缀", version: "android/v2.4", wantStatus: http.StatusUpgradeRequired},
+		{name: "未配置的平台", version: "web/0.0.1", wantStatus: http.StatusOK, wantPlatform: "web", wantAppVersion: "0.0.1"},
+		{name: "版本号格式错误", version: "ios/latest", wantStatus: http.StatusOK, wantPlatform: "ios", wantAppVersion: "latest"},
+		{name: "没有版本", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ctxDeviceID = ClientInfo{}, nil
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.Header.Set(DeviceIDHeader, "device-1")
+			if tt.version != "" {
+				req.Header.Set(ClientVersionHeader, tt.version)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRecommend, w.Header().Get(UpgradeRecommendedHeader))
+			if tt.wantStatus == http.StatusUpgradeRequired {
+				assert.Contains(t, w.Body.String(), `"code":1016`)
+				assert.Contains(t, w.Body.String(), `"min_version"`)
+				return
+			}
+			assert.Equal(t, ClientInfo{DeviceID: "device-1", Platform: tt.wantPlatform, Version: tt.wantAppVersion}, got)
+			assert.Equal(t, "device-1", ctxDeviceID)
+		})
+	}
+
+	// 关闭后不做限制
+	cfg.Enabled = false
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set(ClientVersionHeader, "ios/1.0.0")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// 过长的设备 ID 被忽略
+	req = httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set(DeviceIDHeader, strings.Repeat("d", maxDeviceIDLen+1))
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Empty(t, got.DeviceID)
+	assert.Nil(t, ctxDeviceID)
+}
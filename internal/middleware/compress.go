@@ -0,0 +1,282 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// CompressConfig Compress 中间件配置
+type CompressConfig struct {
+	// MinSize 小于该字节数的响应不压缩，压缩小响应的收益抵不上 CPU 开销
+	MinSize int
+	// ContentTypes 允许压缩的响应类型，以 /* 结尾时匹配该大类，如 text/*
+	ContentTypes []string
+	// GzipLevel gzip 压缩级别，取值参考 compress/gzip
+	GzipLevel int
+	// BrotliLevel brotli 压缩级别，取值 0~11
+	BrotliLevel int
+}
+
+// DefaultCompressConfig 默认配置
+func DefaultCompressConfig() *CompressConfig {
+	return &CompressConfig{
+		MinSize: 1024,
+		ContentTypes: []string{
+			"application/json",
+			"application/problem+json",
+			"application/javascript",
+			"application/xml",
+			"image/svg+xml",
+			"text/*",
+		},
+		GzipLevel:   gzip.DefaultCompression,
+		BrotliLevel: 4,
+	}
+}
+
+// 支持的压缩编码
+const (
+	encodingGzip   = "gzip"
+	encodingBrotli = "br"
+)
+
+// compressor 按配置压缩响应，编码器通过 sync.Pool 复用
+type compressor struct {
+	cfg        *CompressConfig
+	gzipPool   sync.Pool
+	brotliPool sync.Pool
+}
+
+// Compress 根据 Accept-Encoding 对响应做 br 或 gzip 压缩，cfg 为 nil 时使用 DefaultCompressConfig
+//
+// 响应先写入缓冲区，处理完成后根据状态码、Content-Type 及大小决定是否压缩；
+// handler 调用 Flush 时视为流式响应，之后的内容不再压缩直接输出
+func Compress(cfg *CompressConfig) gin.HandlerFunc {
+	if cfg == nil {
+		cfg = DefaultCompressConfig()
+	}
+	z := &compressor{cfg: cfg}
+	z.gzipPool.New = func() interface{} {
+		w, _ := gzip.NewWriterLevel(io.Discard, cfg.GzipLevel)
+		return w
+	}
+	z.brotliPool.New = func() interface{} {
+		return brotli.NewWriterLevel(io.Discard, cfg.BrotliLevel)
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		w := newBufferedWriter(c.Writer)
+		c.Writer = w
+		defer func() { c.Writer = w.ResponseWriter }()
+
+		c.Next()
+
+		if w.passthrough {
+			return
+		}
+		z.finish(c, w)
+	}
+}
+
+// finish 输出缓冲区中的响应，满足条件时压缩
+func (z *compressor) finish(c *gin.Context, w *bufferedWriter) {
+	header := w.Header()
+	body := w.buf.Bytes()
+	if !z.allowType(header.Get("Content-Type")) || !bodyAllowedForStatus(w.status) || header.Get("Content-Encoding") != "" {
+		w.flushBuffer()
+		return
+	}
+
+	// 是否压缩取决于 Accept-Encoding，缓存需要区分
+	header.Add("Vary", "Accept-Encoding")
+	encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+	if encoding == "" || len(body) < z.cfg.MinSize {
+		w.flushBuffer()
+		return
+	}
+
+	var compressed bytes.Buffer
+	switch encoding {
+	case encodingBrotli:
+		bw := z.brotliPool.Get().(*brotli.Writer)
+		bw.Reset(&compressed)
+		bw.Write(body)
+		bw.Close()
+		z.brotliPool.Put(bw)
+	case encodingGzip:
+		gw := z.gzipPool.Get().(*gzip.Writer)
+		gw.Reset(&compressed)
+		gw.Write(body)
+		gw.Close()
+		z.gzipPool.Put(gw)
+	}
+
+	header.Set("Content-Encoding", encoding)
+	header.Set("Content-Length", strconv.Itoa(compressed.Len()))
+	w.buf = compressed
+	w.flushBuffer()
+}
+
+// allowType 判断 Content-Type 是否在允许压缩的列表中
+func (z *compressor) allowType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, t := range z.cfg.ContentTypes {
+		if prefix, ok := strings.CutSuffix(t, "*"); (ok && strings.HasPrefix(mediaType, prefix)) || t == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding 根据 Accept-Encoding 选择压缩编码，q 值相同时优先 br，都不接受时返回空字符串
+func negotiateEncoding(acceptEncoding string) string {
+	qualities := map[string]float64{}
+	wildcard := -1.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		if name == "*" {
+			wildcard = q
+		} else if name != "" {
+			qualities[name] = q
+		}
+	}
+
+	best, bestQ := "", 0.0
+	for _, encoding := range []string{encodingBrotli, encodingGzip} {
+		q, ok := qualities[encoding]
+		if !ok {
+			q = wildcard
+		}
+		if q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+	return best
+}
+
+// bodyAllowedForStatus 判断状态码是否允许携带响应体
+func bodyAllowedForStatus(status int) bool {
+	switch {
+	case status >= 100 && status <= 199:
+		return false
+	case status == http.StatusNoContent, status == http.StatusNotModified:
+		return false
+	}
+	return true
+}
+
+// bufferedWriter 把响应缓存在内存中，处理完成后由中间件决定如何输出
+//
+// Status、Size、Written 反映的是 handler 写入的状态，使内层中间件的判断不受缓冲影响
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status      int
+	wroteHeader bool
+	buf         bytes.Buffer
+	passthrough bool // handler 调用了 Flush，之后直接输出
+}
+
+func newBufferedWriter(w gin.ResponseWriter) *bufferedWriter {
+	return &bufferedWriter{ResponseWriter: w, status: http.StatusOK}
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if code > 0 && !w.wroteHeader {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	w.wroteHeader = true
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+	w.wroteHeader = true
+	return w.buf.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.WriteString(s)
+	}
+	w.wroteHeader = true
+	return w.buf.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	if w.passthrough {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if w.passthrough {
+		return w.ResponseWriter.Size()
+	}
+	if !w.wroteHeader {
+		return -1
+	}
+	return w.buf.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	if w.passthrough {
+		return w.ResponseWriter.Written()
+	}
+	return w.wroteHeader
+}
+
+// Flush 输出已缓存的内容，之后的写入不再缓存
+func (w *bufferedWriter) Flush() {
+	if !w.passthrough {
+		w.flushBuffer()
+		w.passthrough = true
+	}
+	w.ResponseWriter.Flush()
+}
+
+// flushBuffer 把状态码及缓存的内容写入原始响应
+func (w *bufferedWriter) flushBuffer() {
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.buf.Len() > 0 {
+		w.ResponseWriter.Write(w.buf.Bytes())
+	} else if w.wroteHeader {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+	w.buf.Reset()
+}
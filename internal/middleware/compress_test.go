@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	large := strings.Repeat("a", 2048)
+	router := gin.New()
+	router.Use(Compress(nil))
+	router.GET("/json", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"data": large}) })
+	router.GET("/small", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"data": "a"}) })
+	router.GET("/png", func(c *gin.Context) { c.Data(http.StatusOK, "image/png", []byte(large)) })
+	router.GET("/empty", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		wantStatus     int
+		wantEncoding   string
+		wantVary       bool
+	}{
+		{name: "优先 br", path: "/json", acceptEncoding: "gzip, deflate, br", wantStatus: http.StatusOK, wantEncoding: "br", wantVary: true},
+		{name: "gzip", path: "/json", acceptEncoding: "gzip", wantStatus: http.StatusOK, wantEncoding: "gzip", wantVary: true},
+		{name: "按 q 值选择", path: "/json", acceptEncoding: "br;q=0.5, gzip", wantStatus: http.StatusOK, wantEncoding: "gzip", wantVary: true},
+		{name: "通配符", path: "/json", acceptEncoding: "*", wantStatus: http.StatusOK, wantEncoding: "br", wantVary: true},
+		{name: "q=0 拒绝", path: "/json", acceptEncoding: "br;q=0, gzip;q=0", wantStatus: http.StatusOK, wantVary: true},
+		{name: "不支持压缩", path: "/json", wantStatus: http.StatusOK, wantVary: true},
+		{name: "小于最小长度", path: "/small", acceptEncoding: "gzip", wantStatus: http.StatusOK, wantVary: true},
+		{name: "类型不在允许列表", path: "/png", acceptEncoding: "gzip", wantStatus: http.StatusOK},
+		{name: "没有响应体", path: "/empty", acceptEncoding: "gzip", wantStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantEncoding, w.Header().Get("Content-Encoding"))
+			assert.Equal(t, tt.wantVary, w.Header().Get("Vary") == "Accept-Encoding")
+
+			var reader io.Reader = w.Body
+			switch tt.wantEncoding {
+			case "br":
+				reader = brotli.NewReader(w.Body)
+			case "gzip":
+				gr, err := gzip.NewReader(w.Body)
+				require.NoError(t, err)
+				reader = gr
+			}
+			body, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			if tt.path == "/json" {
+				assert.JSONEq(t, `{"data":"`+large+`"}`, string(body))
+			}
+		})
+	}
+}
+
+func TestCompressFlush(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Compress(nil))
+	router.GET("/stream", func(c *gin.Context) {
+		c.Header("Content-Type", "text/plain")
+		c.Writer.WriteString("first")
+		c.Writer.Flush()
+		c.Writer.WriteString(strings.Repeat("b", 2048))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// 流式响应不压缩
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("first")))
+	assert.Equal(t, 5+2048, w.Body.Len())
+}
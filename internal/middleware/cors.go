@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"reflect"
+	"sync/atomic"
+
+	"godemo/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/middleware"
+)
+
+// Cors 跨域中间件，使用 BusinessCfg 中的 [business.cros] 配置，热更新后重新创建，对之后的请求生效
+func Cors() gin.HandlerFunc {
+	h, _ := newCors(config.BusinessCfg.CrosConfig())
+	return h
+}
+
+// newCors 创建跨域中间件并注册热更新回调，返回的函数用于取消注册
+func newCors(cfg middleware.CrosConfig) (gin.HandlerFunc, func()) {
+	var handler atomic.Pointer[gin.HandlerFunc]
+	build := func(cfg middleware.CrosConfig) {
+		h := middleware.Cros(cfg)
+		handler.Store(&h)
+	}
+	build(cfg)
+	cancel := config.OnReload(func(prev, next config.BusinessConfig) {
+		if !reflect.DeepEqual(prev.Cros, next.Cros) {
+			build(next.Cros)
+		}
+	})
+
+	return func(c *gin.Context) {
+		(*handler.Load())(c)
+	}, cancel
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"godemo/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorsReload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	saved := *config.BusinessCfg
+	t.Cleanup(func() { *config.BusinessCfg = saved })
+
+	reload := func(origins ...string) error {
+		v := viper.New()
+		v.Set("business.cros.allow_origins", origins)
+		v.Set("business.cros.allow_methods", []string{"GET", "POST"})
+		return config.BusinessCfg.Reload(v)
+	}
+	require.NoError(t, reload("https://a.example.com"))
+
+	h, cancel := newCors(config.BusinessCfg.CrosConfig())
+	defer cancel()
+	router := gin.New()
+	router.Use(h)
+	router.GET("/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	send := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := send("https://a.example.com")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://a.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, http.StatusForbidden, send("https://b.example.com").Code)
+
+	// 热更新后立即生效
+	require.NoError(t, reload("https://b.example.com"))
+	assert.Equal(t, http.StatusForbidden, send("https://a.example.com").Code)
+	w = send("https://b.example.com")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://b.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+
+	// 配置错误时不更新
+	assert.Error(t, reload("b.example.com"))
+	assert.Equal(t, http.StatusOK, send("https://b.example.com").Code)
+
+	// 取消注册后不再随配置变化
+	cancel()
+	require.NoError(t, reload("https://c.example.com"))
+	assert.Equal(t, http.StatusOK, send("https://b.example.com").Code)
+}
+
+func TestOnReloadPanic(t *testing.T) {
+	logger.Cfg.Closed = true
+	saved := *config.BusinessCfg
+	t.Cleanup(func() { *config.BusinessCfg = saved })
+
+	called := 0
+	cancelPanic := config.OnReload(func(prev, next config.BusinessConfig) { panic("boom") })
+	defer cancelPanic()
+	cancel := config.OnReload(func(prev, next config.BusinessConfig) { called++ })
+	defer cancel()
+
+	// 回调 panic 不影响配置更新及其他回调
+	v := viper.New()
+	v.Set("business.cros.allow_origins", []string{"https://a.example.com"})
+	v.Set("business.maintenance.enabled", true)
+	require.NoError(t, config.BusinessCfg.Reload(v))
+	assert.True(t, config.BusinessCfg.MaintenanceConfig().Enabled)
+	assert.Equal(t, 1, called)
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/response"
+)
+
+// ETag 为 GET 接口的 200 响应生成弱 ETag，请求的 If-None-Match 匹配时返回 304，按路由启用
+//
+// 响应信封中的 trace_id 每次请求都不同，使用 response.APIResult 时只根据 code、message、data 计算 ETag，
+// 压缩不影响内容的语义，因此使用弱 ETag，压缩前后的响应共用一个 ETag
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+		w := newBufferedWriter(c.Writer)
+		c.Writer = w
+		defer func() { c.Writer = w.ResponseWriter }()
+
+		c.Next()
+
+		if w.passthrough {
+			return
+		}
+		if w.status != http.StatusOK || w.buf.Len() == 0 {
+			w.flushBuffer()
+			return
+		}
+
+		etag := responseETag(c, w.buf.Bytes())
+		w.Header().Set("ETag", etag)
+		if etagMatch(c.GetHeader("If-None-Match"), etag) {
+			header := w.Header()
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			w.status = http.StatusNotModified
+			w.buf.Reset()
+		}
+		w.flushBuffer()
+	}
+}
+
+// responseETag 计算响应的弱 ETag
+func responseETag(c *gin.Context, body []byte) string {
+	content := body
+	if output, ok := c.Get(string(constant.CtxAPIOutput)); ok {
+		if result, ok := output.(*response.APIResult); ok {
+			if data, err := json.Marshal([]interface{}{result.Code, result.Message, result.Data}); err == nil {
+				content = data
+			}
+		}
+	}
+	sum := sha256.Sum256(content)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatch 按弱比较判断 If-None-Match 是否匹配 etag
+func etagMatch(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	traceID := 0
+	version := "v1"
+	router := gin.New()
+	router.GET("/areas/version", ETag(), func(c *gin.Context) {
+		// 每次请求的 trace_id 不同，不影响 ETag
+		traceID++
+		result := &response.APIResult{Code: 0, Message: "success", Data: version, TraceID: strconv.Itoa(traceID)}
+		c.Set(string(constant.CtxAPIOutput), result)
+		c.JSON(http.StatusOK, result)
+	})
+	router.GET("/plain", ETag(), func(c *gin.Context) { c.String(http.StatusOK, "hello") })
+	router.GET("/missing", ETag(), func(c *gin.Context) { c.String(http.StatusNotFound, "missing") })
+
+	send := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := send("/areas/version", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, etag)
+
+	tests := []struct {
+		name        string
+		path        string
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		{name: "ETag 匹配", path: "/areas/version", ifNoneMatch: etag, wantStatus: http.StatusNotModified},
+		{name: "多个 ETag 之一匹配", path: "/areas/version", ifNoneMatch: `W/"other", ` + etag, wantStatus: http.StatusNotModified},
+		{name: "强 ETag 弱比较匹配", path: "/areas/version", ifNoneMatch: etag[2:], wantStatus: http.StatusNotModified},
+		{name: "通配符", path: "/areas/version", ifNoneMatch: "*", wantStatus: http.StatusNotModified},
+		{name: "ETag 不匹配", path: "/areas/version", ifNoneMatch: `W/"other"`, wantStatus: http.StatusOK},
+		{name: "非 APIResult 响应", path: "/plain", ifNoneMatch: `W/"other"`, wantStatus: http.StatusOK},
+		{name: "非 200 响应不处理", path: "/missing", ifNoneMatch: "*", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := send(tt.path, tt.ifNoneMatch)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+				assert.Empty(t, w.Header().Get("Content-Type"))
+				assert.Equal(t, etag, w.Header().Get("ETag"))
+			}
+			if tt.wantStatus == http.StatusNotFound {
+				assert.Empty(t, w.Header().Get("ETag"))
+			}
+		})
+	}
+
+	// 数据变化后 ETag 变化
+	version = "v2"
+	w = send("/areas/version", etag)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+}
@@ -0,0 +1,284 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"godemo/internal/apperr"
+	"godemo/internal/wire/provider"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/logger"
+)
+
+const (
+	// IdempotencyKeyHeader 客户端为每个业务操作生成的唯一标识，重试时使用相同的值
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader 响应是重放的已保存结果时为 true
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotencyKeyLen Idempotency-Key 的最大长度
+	maxIdempotencyKeyLen = 255
+)
+
+// 请求处理状态
+const (
+	idempotencyProcessing = "processing"
+	idempotencyDone       = "done"
+)
+
+// idempotencyRecord 保存在 Redis 中的请求指纹及响应
+type idempotencyRecord struct {
+	State       string      `json:"state"`
+	Fingerprint string      `json:"fingerprint"`
+	Status      int         `json:"status,omitempty"`
+	ContentType string      `json:"content_type,omitempty"`
+	Header      http.Header `json:"header,omitempty"` // 重放时恢复的响应头，不包含 idempotencySkipHeaders
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// idempotencySkipHeaders 不保存的响应头：逐跳头部，以及由外层中间件按每个请求设置的头部，
+// 如限流、缓存状态、压缩及跨域（Access-Control-*）相关的头部，重放时由外层中间件重新设置；Content-Type 单独保存在 ContentType 中
+var idempotencySkipHeaders = canonicalHeaderSet(
+	"Connection", "Keep-Alive", "Proxy-Authenticate", "Proxy-Authorization", "Te", "Trailer", "Transfer-Encoding", "Upgrade",
+	"Content-Type", "Content-Length", "Content-Encoding", "Vary", "Date", "Server", "Age", "Retry-After", "X-New-Token",
+	"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", CacheStatusHeader, IdempotentReplayedHeader,
+)
+
+// canonicalHeaderSet 返回以规范化头部名称为 key 的集合
+func canonicalHeaderSet(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[http.CanonicalHeaderKey(name)] = true
+	}
+	return set
+}
+
+// replayableHeader 返回 header 中需要保存的响应头
+func replayableHeader(header http.Header) http.Header {
+	saved := make(http.Header)
+	for name, values := range header {
+		name = http.CanonicalHeaderKey(name)
+		if !idempotencySkipHeaders[name] && !strings.HasPrefix(name, "Access-Control-") {
+			saved[name] = values
+		}
+	}
+	if len(saved) == 0 {
+		return nil
+	}
+	return saved
+}
+
+// Idempotency 基于 Idempotency-Key 请求头的幂等中间件，用于创建类接口
+//
+// 第一次请求处理完成后保存响应，之后相同 key 的重试直接重放保存的响应，不会再次执行 handler；
+// 第一次请求还在处理中时返回 409，相同 key 的请求方法、路由或请求体不同时返回 422。
+// 5xx 响应不保存，客户端可以使用相同的 key 重试。没有 Idempotency-Key 请求头时不做处理
+type Idempotency struct {
+	cache redis.UniversalClient
+	// TTL 响应的保存时间，客户端需要在此时间内完成重试
+	TTL time.Duration
+	// LockTTL 处理中状态的最长保留时间，应大于接口的超时时间，避免进程崩溃后 key 一直处于处理中
+	LockTTL time.Duration
+}
+
+// NewIdempotency 创建使用 MainCache 的 Idempotency
+func NewIdempotency(cache provider.MainCache) *Idempotency {
+	return newIdempotency(cache.UniversalClient)
+}
+
+func newIdempotency(cache redis.UniversalClient) *Idempotency {
+	return &Idempotency{
+		cache:   cache,
+		TTL:     24 * time.Hour,
+		LockTTL: time.Minute,
+	}
+}
+
+// Middleware 幂等中间件
+func (m *Idempotency) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			apperr.Abort(c, apperr.ErrValidation.WithMessage(fmt.Sprintf("%s 不能超过 %d 个字符", IdempotencyKeyHeader, maxIdempotencyKeyLen)))
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			apperr.Abort(c, apperr.ErrValidation.Wrap(err))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		redisKey := idempotencyKey(c, key)
+		fingerprint := requestFingerprint(c, body)
+
+		acquired, record, err := m.acquire(ctx, redisKey, fingerprint)
+		if err != nil {
+			// Redis 不可用时不阻塞业务，按没有 Idempotency-Key 处理
+			logger.Error(ctx, "IDEMPOTENCY", fmt.Errorf("idempotency check failed, key %s: %w", redisKey, err))
+			c.Next()
+			return
+		}
+		if !acquired {
+			m.reject(c, record, fingerprint)
+			return
+		}
+
+		// 保存结果不受请求超时影响，避免处理完成但因 deadline 已过无法保存，导致重试时再次执行
+		ctx = context.WithoutCancel(ctx)
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		completed := false
+		defer func() {
+			// handler panic 或 5xx 时删除处理中状态，允许客户端重试
+			if !completed {
+				m.release(ctx, redisKey)
+			}
+		}()
+
+		c.Next()
+
+		// 没有输出响应（如超时后由外层中间件输出）或 5xx 时不保存
+		if !c.Writer.Written() || c.Writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+		completed = true
+		m.save(ctx, redisKey, &idempotencyRecord{
+			State:       idempotencyDone,
+			Fingerprint: fingerprint,
+			Status:      c.Writer.Status(),
+			ContentType: c.Writer.Header().Get("Content-Type"),
+			Header:      replayableHeader(c.Writer.Header()),
+			Body:        writer.body.Bytes(),
+		})
+	}
+}
+
+// acquire 尝试把 key 标记为处理中，key 已存在时返回已有的记录
+func (m *Idempotency) acquire(ctx context.Context, redisKey, fingerprint string) (bool, *idempotencyRecord, error) {
+	processing, _ := json.Marshal(&idempotencyRecord{State: idempotencyProcessing, Fingerprint: fingerprint})
+	// 已有记录在 SETNX 与 GET 之间过期时再尝试一次
+	for i := 0; i < 2; i++ {
+		ok, err := m.cache.SetNX(ctx, redisKey, processing, m.LockTTL).Result()
+		if err != nil {
+			return false, nil, err
+		}
+		if ok {
+			return true, nil, nil
+		}
+
+		data, err := m.cache.Get(ctx, redisKey).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return false, nil, err
+		}
+		record := &idempotencyRecord{}
+		if err := json.Unmarshal(data, record); err != nil {
+			return false, nil, fmt.Errorf("decode idempotency record: %w", err)
+		}
+		return false, record, nil
+	}
+	return false, &idempotencyRecord{State: idempotencyProcessing, Fingerprint: fingerprint}, nil
+}
+
+// reject 处理 key 已被使用的请求：请求不同返回 422，处理中返回 409，否则重放保存的响应及响应头
+func (m *Idempotency) reject(c *gin.Context, record *idempotencyRecord, fingerprint string) {
+	switch {
+	case record.Fingerprint != fingerprint:
+		apperr.Abort(c, apperr.ErrIdempotencyMismatch)
+	case record.State != idempotencyDone:
+		apperr.Abort(c, apperr.ErrIdempotencyInFlight)
+	default:
+		header := c.Writer.Header()
+		for name, values := range record.Header {
+			header[name] = values
+		}
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(record.Status, record.ContentType, record.Body)
+		c.Abort()
+	}
+}
+
+func (m *Idempotency) save(ctx context.Context, redisKey string, record *idempotencyRecord) {
+	data, _ := json.Marshal(record)
+	if err := m.cache.Set(ctx, redisKey, data, m.TTL).Err(); err != nil {
+		logger.Error(ctx, "IDEMPOTENCY", fmt.Errorf("save idempotency record failed, key %s: %w", redisKey, err))
+	}
+}
+
+func (m *Idempotency) release(ctx context.Context, redisKey string) {
+	if err := m.cache.Del(ctx, redisKey).Err(); err != nil {
+		logger.Error(ctx, "IDEMPOTENCY", fmt.Errorf("release idempotency key failed, key %s: %w", redisKey, err))
+	}
+}
+
+// idempotencyKey 生成 Redis key，按调用方隔离，避免不同客户端的 key 冲突
+//
+// 未认证的请求按客户端 IP 及设备 ID 隔离，不同的匿名调用方不能占用或读取彼此的 key
+func idempotencyKey(c *gin.Context, key string) string {
+	scope := callerScope(c)
+	if scope == anonymousScope {
+		scope += ":" + c.ClientIP() + ":" + CurrentClient(c).DeviceID
+	}
+	return "idempotency:" + scope + ":" + key
+}
+
+// anonymousScope 未认证请求的调用方
+const anonymousScope = "anonymous"
+
+// callerScope 请求的调用方：登录用户为 user:<id>，签名校验通过的 API Key 为 api_key:<key>，否则为 anonymous
+//
+// 只使用认证后的身份，请求头中的 X-Api-Key 未经校验且 key ID 是公开的，不能用于区分调用方
+func callerScope(c *gin.Context) string {
+	if userID, ok := c.Get(string(constant.CtxUserID)); ok && fmt.Sprint(userID) != "" {
+		return "user:" + fmt.Sprint(userID)
+	}
+	if key, ok := CurrentAPIKey(c); ok {
+		return "api_key:" + key.KeyID
+	}
+	return anonymousScope
+}
+
+// requestFingerprint 请求方法、路由及请求体的摘要
+func requestFingerprint(c *gin.Context, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// bodyCaptureWriter 在写入响应的同时保存一份响应体
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	m := newIdempotency(client)
+
+	calls := 0
+	status := http.StatusOK
+	requests := 0
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		// 外层中间件按每个请求设置的响应头
+		requests++
+		c.Header("RateLimit-Remaining", strconv.Itoa(requests))
+	})
+	router.POST("/api/v1/users", m.Middleware(), func(c *gin.Context) {
+		calls++
+		c.Header("Location", "/api/v1/users/"+strconv.Itoa(calls))
+		c.JSON(status, gin.H{"id": calls})
+	})
+
+	sendFrom := func(remoteAddr string, headers map[string]string, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users", strings.NewReader(body))
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	send := func(key, body string) *httptest.ResponseRecorder {
+		return sendFrom("1.2.3.4:1234", nil, key, body)
+	}
+
+	// 第一次请求执行 handler，重试时重放保存的响应
+	w := send("k1", `{"username":"tom"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id":1}`, w.Body.String())
+
+	w = send("k1", `{"username":"tom"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id":1}`, w.Body.String())
+	assert.Equal(t, "true", w.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "/api/v1/users/1", w.Header().Get("Location"))
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, 1, calls)
+
+	// 复用 key 提交不同的请求体
+	w = send("k1", `{"username":"jerry"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":1010`)
+
+	// 相同 key 的请求处理中
+	processing := `{"state":"processing","fingerprint":"` + requestFingerprintForTest(`{"username":"lucy"}`) + `"}`
+	assert.NoError(t, mr.Set("idempotency:anonymous:1.2.3.4::k2", processing))
+	w = send("k2", `{"username":"lucy"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":1009`)
+
+	// 5xx 不保存，可以使用相同的 key 重试
+	status = http.StatusInternalServerError
+	w = send("k3", `{"username":"bob"}`)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.False(t, mr.Exists("idempotency:anonymous:1.2.3.4::k3"))
+	status = http.StatusOK
+	w = send("k3", `{"username":"bob"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+
+	// 没有 Idempotency-Key 时每次都执行
+	before := calls
+	send("", `{"username":"tom"}`)
+	send("", `{"username":"tom"}`)
+	assert.Equal(t, before+2, calls)
+
+	// 匿名调用方按 IP 隔离，伪造的 X-Api-Key 不能占用合作方的 key
+	before = calls
+	w = sendFrom("5.6.7.8:1234", map[string]string{APIKeyHeader: "ak_partner"}, "k1", `{"username":"tom"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, before+1, calls)
+	assert.True(t, mr.Exists("idempotency:anonymous:5.6.7.8::k1"))
+	assert.False(t, mr.Exists("idempotency:api_key:ak_partner:k1"))
+
+	// key 过长
+	w = send(strings.Repeat("k", 256), `{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestIdempotencyRedisDown(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	defer client.Close()
+	mr.Close()
+
+	router := gin.New()
+	router.POST("/test", newIdempotency(client).Middleware(), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(`{}`))
+	req.Header.Set(IdempotencyKeyHeader, "k1")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func requestFingerprintForTest(body string) string {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users", nil)
+	return requestFingerprint(c, []byte(body))
+}
@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/logger"
+)
+
+// IOLogConfig IOLog 中间件配置
+type IOLogConfig struct {
+	LogRequestBody     bool          // 是否记录请求体，只记录 JSON 及 urlencoded 请求体
+	LogResponseBody    bool          // 是否记录响应
+	MaxRequestBodySize int64         // 记录的请求体最大字节数，超过时只记录提示
+	LogHeaders         bool          // 是否记录请求头
+	LogQuery           bool          // 是否记录 query
+	Redact             *RedactConfig // 脱敏配置，为 nil 时使用 DefaultRedactConfig
+}
+
+// DefaultIOLogConfig 默认配置
+func DefaultIOLogConfig() *IOLogConfig {
+	return &IOLogConfig{
+		LogRequestBody:     true,
+		LogResponseBody:    true,
+		MaxRequestBodySize: 1 << 20,
+		LogQuery:           true,
+	}
+}
+
+// IOLog 记录请求及响应日志，cfg 为 nil 时使用 DefaultIOLogConfig
+//
+// 与 gocommon 的 IOLog 相比，请求体、响应、query 及请求头中的敏感数据在写入日志前按 Redactor 脱敏，
+// multipart 请求体不读取，避免把上传的文件读入内存
+func IOLog(cfg *IOLogConfig) gin.HandlerFunc {
+	if cfg == nil {
+		cfg = DefaultIOLogConfig()
+	}
+	redactor := NewRedactor(cfg.Redact)
+
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		var requestBody interface{}
+		if cfg.LogRequestBody {
+			requestBody = readLogBody(c, cfg.MaxRequestBodySize, redactor)
+		}
+
+		c.Next()
+
+		fields := map[string]interface{}{
+			"duration":        time.Since(start),
+			"method":          c.Request.Method,
+			"status":          c.Writer.Status(),
+			"path":            c.Request.URL.Path,
+			"client_ip":       c.ClientIP(),
+			"user_agent":      c.Request.UserAgent(),
+			"response_length": c.Writer.Size(),
+		}
+		if cfg.LogQuery {
+			fields["query"] = redactor.Query(c.Request.URL.RawQuery)
+		}
+		if cfg.LogHeaders {
+			fields["headers"] = redactor.Header(c.Request.Header)
+		}
+		if requestBody != nil {
+			fields["request_body"] = requestBody
+		}
+		if cfg.LogResponseBody {
+			if output, ok := c.Get(string(constant.CtxAPIOutput)); ok && output != nil {
+				fields["response"] = redactor.Value(output)
+			}
+		}
+
+		logFunc := logger.InfoWithField
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			logFunc = logger.ErrorWithField
+		}
+		logFunc(c.Request.Context(), "IOLOG", http.StatusText(c.Writer.Status()), fields)
+	}
+}
+
+// readLogBody 读取并脱敏需要记录的请求体，读取的内容会放回请求体供后续处理
+//
+// 只读取 maxSize+1 个字节，超过时剩余部分留在原请求体中，不会因为记录日志把大请求体整个读入内存
+func readLogBody(c *gin.Context, maxSize int64, redactor *Redactor) interface{} {
+	ct := c.ContentType()
+	isJSON := ct == gin.MIMEJSON
+	if (!isJSON && ct != gin.MIMEPOSTForm) || c.Request.Body == nil || c.Request.Body == http.NoBody {
+		return nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(c.Request.Body, maxSize+1))
+	c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(data), c.Request.Body), c.Request.Body}
+	switch {
+	case err != nil || len(data) == 0:
+		return nil
+	case int64(len(data)) > maxSize:
+		return "[请求体超过大小限制]"
+	case isJSON:
+		redacted, ok := redactor.JSON(data)
+		if !ok {
+			// 不是合法的 JSON 时无法按字段脱敏，不记录原文
+			return "[非法的 JSON 请求体]"
+		}
+		return redacted
+	}
+	return redactor.Query(string(data))
+}
+
+// readCloser 读取 Reader，关闭时关闭原请求体
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"godemo/config"
+	"godemo/internal/apperr"
+
+	"github.com/gin-gonic/gin"
+)
+
+// healthPathPrefix 健康检查路由的前缀，维护期间仍然可以访问，避免负载均衡摘除实例
+const healthPathPrefix = "/health/"
+
+// Maintenance 维护模式，开启后除健康检查及 allow_ips 中的 IP 外，所有请求返回 503，配置从 BusinessConfig 中实时读取
+func Maintenance() gin.HandlerFunc {
+	return maintenance(config.BusinessCfg.MaintenanceConfig)
+}
+
+func maintenance(cfg func() config.MaintenanceConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		current := cfg()
+		if !current.Enabled || strings.HasPrefix(c.Request.URL.Path, healthPathPrefix) || ipInList(c.ClientIP(), current.AllowIPs) {
+			c.Next()
+			return
+		}
+
+		if current.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(current.RetryAfter.Seconds()))))
+		}
+		err := apperr.ErrMaintenance
+		if current.Message != "" {
+			err = err.WithMessage(current.Message)
+		}
+		apperr.Abort(c, err)
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"godemo/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	cfg := config.MaintenanceConfig{
+		Enabled:    true,
+		RetryAfter: 90 * time.Second,
+		AllowIPs:   []string{"10.0.0.0/8", "192.168.1.10"},
+	}
+	router := gin.New()
+	router.Use(maintenance(func() config.MaintenanceConfig { return cfg }))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/health/check", ok)
+	router.GET("/api/v1/users", ok)
+
+	tests := []struct {
+		name       string
+		path       string
+		remoteAddr string
+		wantStatus int
+	}{
+		{name: "维护中", path: "/api/v1/users", remoteAddr: "1.2.3.4:1234", wantStatus: http.StatusServiceUnavailable},
+		{name: "健康检查", path: "/health/check", remoteAddr: "1.2.3.4:1234", wantStatus: http.StatusOK},
+		{name: "CIDR 允许", path: "/api/v1/users", remoteAddr: "10.1.2.3:1234", wantStatus: http.StatusOK},
+		{name: "IP 允许", path: "/api/v1/users", remoteAddr: "192.168.1.10:1234", wantStatus: http.StatusOK},
+		{name: "IP 不在名单", path: "/api/v1/users", remoteAddr: "192.168.1.11:1234", wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.RemoteAddr = tt.remoteAddr
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusServiceUnavailable {
+				assert.Equal(t, "90", w.Header().Get("Retry-After"))
+				assert.Contains(t, w.Body.String(), `"code":1017`)
+			}
+		})
+	}
+
+	// 自定义提示，关闭后恢复
+	cfg.Message = "系统升级中，预计 10 分钟后恢复"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/users", nil))
+	assert.Contains(t, w.Body.String(), cfg.Message)
+
+	cfg.Enabled = false
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/users", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
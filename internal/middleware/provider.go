@@ -0,0 +1,10 @@
+package middleware
+
+import "github.com/google/wire"
+
+var ProviderSet = wire.NewSet(
+	NewRateLimiter,
+	NewIdempotency,
+	NewResponseCache,
+	NewAPIKeyAuth,
+)
@@ -0,0 +1,341 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"godemo/config"
+	"godemo/internal/apperr"
+	"godemo/internal/wire/provider"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/constant"
+	"github.com/jessewkun/gocommon/logger"
+	"golang.org/x/time/rate"
+)
+
+// slidingWindowScript 滑动窗口，用有序集合记录窗口内每次请求的时间
+//
+// 返回 {是否允许, 剩余次数, 距离窗口内最早的请求过期的毫秒数}
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, limit - count, reset}
+`)
+
+// tokenBucketScript 令牌桶，桶容量为 limit，每 window 毫秒补满
+//
+// 返回 {是否允许, 剩余令牌数, 被拒绝时距离下一个令牌的毫秒数，否则为距离补满的毫秒数}
+var tokenBucketScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local rate = limit / window
+
+local data = redis.call('HMGET', key, 'tokens', 'ts')
+local tokens = tonumber(data[1]) or limit
+local ts = tonumber(data[2]) or now
+tokens = math.min(limit, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local reset
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+	reset = (limit - tokens) / rate
+else
+	reset = (1 - tokens) / rate
+end
+redis.call('HSET', key, 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', key, window)
+return {allowed, math.floor(tokens), math.ceil(reset)}
+`)
+
+// redisRetryInterval Redis 出错后使用进程内限流的时间，避免 Redis 不可用时每个请求都等待超时
+const redisRetryInterval = 5 * time.Second
+
+// rateLimitResult 一条规则的限流结果
+type rateLimitResult struct {
+	rule      config.RateLimitRule
+	allowed   bool
+	remaining int
+	reset     time.Duration
+}
+
+// RateLimiter 基于 Redis 的分布式限流，规则从 BusinessConfig 中实时读取，热更新后立即生效
+//
+// Redis 不可用时退化为进程内限流，此时每个实例单独计数
+type RateLimiter struct {
+	cache     redis.UniversalClient
+	rules     func() config.RateLimitConfig
+	local     *localLimiter
+	downUntil atomic.Int64 // Redis 出错后在此时间之前不再访问 Redis，UnixNano
+	instance  string       // 进程随机标识，与 seq 一起保证多个实例写入滑动窗口的成员不重复
+	seq       atomic.Uint64
+	now       func() time.Time
+}
+
+// NewRateLimiter 创建使用 MainCache 及 BusinessCfg 中限流规则的 RateLimiter
+func NewRateLimiter(cache provider.MainCache) *RateLimiter {
+	return newRateLimiter(cache.UniversalClient, config.BusinessCfg.RateLimitConfig)
+}
+
+func newRateLimiter(cache redis.UniversalClient, rules func() config.RateLimitConfig) *RateLimiter {
+	instance := make([]byte, 8)
+	_, _ = rand.Read(instance)
+	return &RateLimiter{
+		cache:    cache,
+		rules:    rules,
+		local:    newLocalLimiter(),
+		instance: hex.EncodeToString(instance),
+		now:      time.Now,
+	}
+}
+
+// ctxRateLimitResult gin.Context 中保存已设置到响应头的限流结果的 key，认证后的限流与全局限流比较后取剩余次数更少的
+const ctxRateLimitResult = "rate_limit_result"
+
+// Middleware 全局限流中间件，只处理 ip、route 维度的规则，api_key、user 维度的规则由 AuthenticatedMiddleware 处理
+//
+// 响应中带有 RateLimit-Limit、RateLimit-Remaining、RateLimit-Reset 及 RateLimit-Policy 头，
+// 超过限制时返回 429 及 Retry-After 头
+func (l *RateLimiter) Middleware() gin.HandlerFunc {
+	return l.middleware(func(rule config.RateLimitRule) bool { return !authenticatedKeyBy(rule.KeyBy) })
+}
+
+// AuthenticatedMiddleware 处理 api_key、user 维度的规则，需要在认证中间件之后使用，按认证通过的调用方计数
+//
+// 请求头中的 X-Api-Key 未经校验，在认证前按其计数时，任何人都可以用合作方公开的 key ID 耗尽其配额，
+// 或者每次换一个值绕过限制。没有认证信息时按 IP 计数
+func (l *RateLimiter) AuthenticatedMiddleware() gin.HandlerFunc {
+	return l.middleware(func(rule config.RateLimitRule) bool { return authenticatedKeyBy(rule.KeyBy) })
+}
+
+// authenticatedKeyBy 限流维度是否依赖认证后的调用方
+func authenticatedKeyBy(keyBy string) bool {
+	return keyBy == config.RateLimitByAPIKey || keyBy == config.RateLimitByUser
+}
+
+// middleware 对 include 选出的规则限流
+func (l *RateLimiter) middleware(include func(config.RateLimitRule) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cfg := l.rules()
+		if !cfg.Enabled {
+			c.Next()
+			return
+		}
+
+		// 命中多条规则时全部计数，响应头使用剩余次数最少的规则
+		var tightest *rateLimitResult
+		if value, ok := c.Get(ctxRateLimitResult); ok {
+			tightest, _ = value.(*rateLimitResult)
+		}
+		matched := false
+		for _, rule := range cfg.Rules {
+			if !include(rule) || !matchRule(rule, c) {
+				continue
+			}
+			matched = true
+			result := l.allow(c.Request.Context(), rule, rateLimitKey(rule, c))
+			if tightest == nil || !result.allowed || (tightest.allowed && result.remaining < tightest.remaining) {
+				tightest = &result
+			}
+			if !result.allowed {
+				break
+			}
+		}
+		if !matched {
+			c.Next()
+			return
+		}
+		c.Set(ctxRateLimitResult, tightest)
+
+		header := c.Writer.Header()
+		reset := strconv.Itoa(int(math.Ceil(tightest.reset.Seconds())))
+		header.Set("RateLimit-Limit", strconv.Itoa(tightest.rule.Limit))
+		header.Set("RateLimit-Remaining", strconv.Itoa(tightest.remaining))
+		header.Set("RateLimit-Reset", reset)
+		header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", tightest.rule.Limit, int(tightest.rule.Window.Seconds())))
+		if !tightest.allowed {
+			header.Set("Retry-After", reset)
+			apperr.Abort(c, apperr.ErrRateLimited)
+			return
+		}
+		c.Next()
+	}
+}
+
+// allow 优先使用 Redis 计数，Redis 出错时退化为进程内限流
+func (l *RateLimiter) allow(ctx context.Context, rule config.RateLimitRule, key string) rateLimitResult {
+	now := l.now()
+	if l.cache != nil && now.UnixNano() >= l.downUntil.Load() {
+		result, err := l.allowRedis(ctx, rule, key, now)
+		if err == nil {
+			return result
+		}
+		l.downUntil.Store(now.Add(redisRetryInterval).UnixNano())
+		logger.Error(ctx, "RATE_LIMIT", fmt.Errorf("redis rate limit failed, fallback to local limiter for %s: %w", redisRetryInterval, err))
+	}
+	return l.local.allow(rule, key, now)
+}
+
+func (l *RateLimiter) allowRedis(ctx context.Context, rule config.RateLimitRule, key string, now time.Time) (rateLimitResult, error) {
+	script := slidingWindowScript
+	if rule.Algorithm == config.RateLimitTokenBucket {
+		script = tokenBucketScript
+	}
+	nowMs := now.UnixMilli()
+	member := strconv.FormatInt(nowMs, 10) + "-" + l.instance + "-" + strconv.FormatUint(l.seq.Add(1), 10)
+	values, err := script.Run(ctx, l.cache, []string{key}, nowMs, rule.Window.Milliseconds(), rule.Limit, member).Int64Slice()
+	if err != nil {
+		return rateLimitResult{}, err
+	}
+	if len(values) != 3 {
+		return rateLimitResult{}, fmt.Errorf("unexpected rate limit script result %v", values)
+	}
+	return rateLimitResult{
+		rule:      rule,
+		allowed:   values[0] == 1,
+		remaining: int(values[1]),
+		reset:     time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
+
+// matchRule 判断请求是否命中规则
+func matchRule(rule config.RateLimitRule, c *gin.Context) bool {
+	if len(rule.Methods) > 0 && !containsFold(rule.Methods, c.Request.Method) {
+		return false
+	}
+	if len(rule.Paths) == 0 {
+		return true
+	}
+	path := c.FullPath()
+	if path == "" {
+		path = c.Request.URL.Path
+	}
+	for _, p := range rule.Paths {
+		if prefix, ok := strings.CutSuffix(p, "*"); (ok && strings.HasPrefix(path, prefix)) || p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitKey 根据限流维度生成计数的 key，同名规则共享计数
+func rateLimitKey(rule config.RateLimitRule, c *gin.Context) string {
+	var id string
+	switch rule.KeyBy {
+	case config.RateLimitByUser:
+		if userID, ok := c.Get(string(constant.CtxUserID)); ok && fmt.Sprint(userID) != "" {
+			id = "user:" + fmt.Sprint(userID)
+		}
+	case config.RateLimitByAPIKey:
+		if key, ok := CurrentAPIKey(c); ok {
+			id = "api_key:" + key.KeyID
+		}
+	case config.RateLimitByRoute:
+		id = "route:" + c.Request.Method + ":" + c.FullPath()
+	}
+	if id == "" {
+		id = "ip:" + c.ClientIP()
+	}
+	return "ratelimit:" + rule.Name + ":" + id
+}
+
+// localLimiter Redis 不可用时使用的进程内限流，两种算法都按令牌桶近似处理
+type localLimiter struct {
+	mu        sync.Mutex
+	limiters  map[string]*localEntry
+	lastSweep time.Time
+}
+
+type localEntry struct {
+	limiter  *rate.Limiter
+	window   time.Duration
+	lastSeen time.Time
+}
+
+// localSweepInterval 清理长时间未使用的限流器的间隔
+const localSweepInterval = time.Minute
+
+func newLocalLimiter() *localLimiter {
+	return &localLimiter{limiters: make(map[string]*localEntry)}
+}
+
+func (l *localLimiter) allow(rule config.RateLimitRule, key string, now time.Time) rateLimitResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	// 规则热更新后 key 不变但限制可能变化，限制变化时重建
+	limit := rate.Limit(float64(rule.Limit) / rule.Window.Seconds())
+	entry, ok := l.limiters[key]
+	if !ok || entry.limiter.Burst() != rule.Limit || entry.limiter.Limit() != limit {
+		entry = &localEntry{limiter: rate.NewLimiter(limit, rule.Limit), window: rule.Window}
+		l.limiters[key] = entry
+	}
+	entry.lastSeen = now
+
+	allowed := entry.limiter.AllowN(now, 1)
+	tokens := entry.limiter.TokensAt(now)
+	reset := time.Duration((float64(rule.Limit) - tokens) / float64(limit) * float64(time.Second))
+	if !allowed {
+		reset = time.Duration((1 - tokens) / float64(limit) * float64(time.Second))
+	}
+	return rateLimitResult{
+		rule:      rule,
+		allowed:   allowed,
+		remaining: int(math.Max(0, math.Floor(tokens))),
+		reset:     reset,
+	}
+}
+
+// sweep 删除超过一个窗口未使用的限流器，此时令牌桶已经补满，删除后重建的结果相同
+func (l *localLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < localSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, entry := range l.limiters {
+		if now.Sub(entry.lastSeen) > entry.window {
+			delete(l.limiters, key)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"godemo/config"
+	"godemo/internal/model"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRateLimiter 创建使用 miniredis 及固定时钟的 RateLimiter，返回的函数用于拨动时钟
+func newTestRateLimiter(t *testing.T, cfg config.RateLimitConfig) (*RateLimiter, *miniredis.Miniredis, func(time.Duration)) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { client.Close() })
+
+	l := newRateLimiter(client, func() config.RateLimitConfig { return cfg })
+	now := time.Unix(1700000000, 0)
+	l.now = func() time.Time { return now }
+	return l, mr, func(d time.Duration) { now = now.Add(d) }
+}
+
+func serveRateLimit(l *RateLimiter, method, path string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.Use(l.Middleware())
+	handler := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/users", handler)
+	router.POST("/api/v1/users", handler)
+	router.GET("/api/v1/areas/search", handler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w
+}
+
+func TestRateLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	tests := []struct {
+		name      string
+		algorithm string
+	}{
+		{name: "滑动窗口", algorithm: config.RateLimitSlidingWindow},
+		{name: "令牌桶", algorithm: config.RateLimitTokenBucket},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _, advance := newTestRateLimiter(t, config.RateLimitConfig{
+				Enabled: true,
+				Rules: []config.RateLimitRule{
+					{Name: "ip", KeyBy: config.RateLimitByIP, Algorithm: tt.algorithm, Limit: 2, Window: time.Minute},
+				},
+			})
+
+			w := serveRateLimit(l, http.MethodGet, "/api/v1/users")
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+			assert.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+			assert.Equal(t, "2;w=60", w.Header().Get("RateLimit-Policy"))
+
+			w = serveRateLimit(l, http.MethodGet, "/api/v1/users")
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+
+			w = serveRateLimit(l, http.MethodGet, "/api/v1/users")
+			assert.Equal(t, http.StatusTooManyRequests, w.Code)
+			assert.Contains(t, w.Body.String(), `"code":1004`)
+			assert.NotEmpty(t, w.Header().Get("Retry-After"))
+
+			// 一个窗口后恢复
+			advance(time.Minute)
+			w = serveRateLimit(l, http.MethodGet, "/api/v1/users")
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestRateLimiterInstances(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	cfg := config.RateLimitConfig{
+		Enabled: true,
+		Rules: []config.RateLimitRule{
+			{Name: "ip", KeyBy: config.RateLimitByIP, Algorithm: config.RateLimitSlidingWindow, Limit: 2, Window: time.Minute},
+		},
+	}
+	// 两个实例共用一个 Redis，同一毫秒内的请求也要分别计数
+	a, mr, _ := newTestRateLimiter(t, cfg)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { client.Close() })
+	b := newRateLimiter(client, func() config.RateLimitConfig { return cfg })
+	b.now = a.now
+
+	assert.Equal(t, http.StatusOK, serveRateLimit(a, http.MethodGet, "/api/v1/users").Code)
+	assert.Equal(t, http.StatusOK, serveRateLimit(b, http.MethodGet, "/api/v1/users").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimit(a, http.MethodGet, "/api/v1/users").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimit(b, http.MethodGet, "/api/v1/users").Code)
+}
+
+func TestRateLimiterRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	l, mr, _ := newTestRateLimiter(t, config.RateLimitConfig{
+		Enabled: true,
+		Rules: []config.RateLimitRule{
+			{Name: "create", Paths: []string{"/api/v1/users"}, Methods: []string{"POST"}, KeyBy: config.RateLimitByIP, Limit: 1, Window: time.Minute},
+			{Name: "areas", Paths: []string{"/api/v1/areas/*"}, KeyBy: config.RateLimitByRoute, Limit: 5, Window: time.Minute},
+		},
+	})
+
+	assert.Equal(t, http.StatusOK, serveRateLimit(l, http.MethodPost, "/api/v1/users").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimit(l, http.MethodPost, "/api/v1/users").Code)
+
+	// 方法不匹配时不限流，也不返回限流头
+	w := serveRateLimit(l, http.MethodGet, "/api/v1/users")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("RateLimit-Limit"))
+
+	// 前缀匹配，按路由计数
+	w = serveRateLimit(l, http.MethodGet, "/api/v1/areas/search?keyword=hz")
+	assert.Equal(t, "4", w.Header().Get("RateLimit-Remaining"))
+	assert.True(t, mr.Exists("ratelimit:areas:route:GET:/api/v1/areas/search"))
+}
+
+func TestRateLimiterAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	l, mr, _ := newTestRateLimiter(t, config.RateLimitConfig{
+		Enabled: true,
+		Rules: []config.RateLimitRule{
+			{Name: "ip", KeyBy: config.RateLimitByIP, Limit: 10, Window: time.Minute},
+			{Name: "partner", Paths: []string{"/api/v1/partner/*"}, KeyBy: config.RateLimitByAPIKey, Limit: 2, Window: time.Minute},
+		},
+	})
+	verifier := &stubAPIKeyVerifier{keys: map[string]*model.APIKey{"ak_partner": {KeyID: "ak_partner", Scopes: "*"}}}
+	router := gin.New()
+	router.Use(l.Middleware())
+	router.GET("/api/v1/partner/users", (&APIKeyAuth{verifier: verifier}).Middleware(), l.AuthenticatedMiddleware(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	send := func(keyID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/partner/users", nil)
+		req.Header.Set(APIKeyHeader, keyID)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// 签名错误的请求不消耗合作方的配额，只按 IP 计数
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusUnauthorized, send("ak_partner_forged").Code)
+	}
+	assert.False(t, mr.Exists("ratelimit:partner:api_key:ak_partner_forged"))
+
+	w := send("ak_partner")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+	assert.True(t, mr.Exists("ratelimit:partner:api_key:ak_partner"))
+	assert.Equal(t, http.StatusOK, send("ak_partner").Code)
+	assert.Equal(t, http.StatusTooManyRequests, send("ak_partner").Code)
+}
+
+func TestRateLimiterFallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	l, mr, _ := newTestRateLimiter(t, config.RateLimitConfig{
+		Enabled: true,
+		Rules: []config.RateLimitRule{
+			{Name: "ip", KeyBy: config.RateLimitByIP, Limit: 2, Window: time.Minute},
+		},
+	})
+	mr.Close()
+
+	w := serveRateLimit(l, http.MethodGet, "/api/v1/users")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, http.StatusOK, serveRateLimit(l, http.MethodGet, "/api/v1/users").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimit(l, http.MethodGet, "/api/v1/users").Code)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	l, _, _ := newTestRateLimiter(t, config.RateLimitConfig{
+		Rules: []config.RateLimitRule{
+			{Name: "ip", KeyBy: config.RateLimitByIP, Limit: 1, Window: time.Minute},
+		},
+	})
+
+	for i := 0; i < 3; i++ {
+		w := serveRateLimit(l, http.MethodGet, "/api/v1/users")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("RateLimit-Limit"))
+	}
+}
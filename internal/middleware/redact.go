@@ -0,0 +1,229 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"godemo/internal/idcard"
+
+	"github.com/jessewkun/gocommon/utils"
+)
+
+// MaskRule 脱敏规则
+type MaskRule string
+
+const (
+	MaskFull   MaskRule = "full"   // 整体替换为固定长度的掩码，不暴露原值长度
+	MaskEmail  MaskRule = "email"  // 邮箱保留用户名首字符及域名，如 t***@example.com
+	MaskPhone  MaskRule = "phone"  // 手机号保留前3位和后4位，如 138****5678
+	MaskIDCard MaskRule = "idcard" // 身份证号保留前4位和后4位
+)
+
+// fullMask MaskFull 使用的掩码
+const fullMask = "******"
+
+// RedactConfig 日志脱敏配置
+type RedactConfig struct {
+	// Fields JSON 请求体及响应中需要脱敏的字段，匹配规则与 TrimConfig.SkipFields 相同，字段名不区分大小写
+	Fields map[string]MaskRule
+	// Headers 需要脱敏的请求头，不区分大小写
+	Headers []string
+	// Query 需要脱敏的 query 及 urlencoded 表单参数，不区分大小写
+	Query map[string]MaskRule
+}
+
+// DefaultRedactConfig 默认脱敏配置
+func DefaultRedactConfig() *RedactConfig {
+	return &RedactConfig{
+		Fields: map[string]MaskRule{
+			"password":      MaskFull,
+			"token":         MaskFull,
+			"access_token":  MaskFull,
+			"refresh_token": MaskFull,
+			"secret":        MaskFull,
+			"email":         MaskEmail,
+			"phone":         MaskPhone,
+			"mobile":        MaskPhone,
+			"id_card":       MaskIDCard,
+		},
+		Headers: []string{"Authorization", "X-Refresh-Token", "Cookie", "X-Api-Key"},
+		Query: map[string]MaskRule{
+			"password":      MaskFull,
+			"token":         MaskFull,
+			"access_token":  MaskFull,
+			"refresh_token": MaskFull,
+			"email":         MaskEmail,
+			"phone":         MaskPhone,
+			"mobile":        MaskPhone,
+		},
+	}
+}
+
+// Redactor 按配置对请求、响应中的敏感数据脱敏，用于写日志前处理，不修改原始数据
+type Redactor struct {
+	names   map[string]MaskRule // 任意层级的字段名
+	full    map[string]MaskRule // 从根开始的完整路径
+	headers map[string]bool
+	query   map[string]MaskRule
+}
+
+// NewRedactor 创建 Redactor，cfg 为 nil 时使用 DefaultRedactConfig
+func NewRedactor(cfg *RedactConfig) *Redactor {
+	if cfg == nil {
+		cfg = DefaultRedactConfig()
+	}
+	r := &Redactor{
+		names:   make(map[string]MaskRule),
+		full:    make(map[string]MaskRule),
+		headers: make(map[string]bool),
+		query:   make(map[string]MaskRule),
+	}
+	for field, rule := range cfg.Fields {
+		field = strings.ToLower(field)
+		if strings.Contains(field, ".") {
+			r.full[field] = rule
+		} else {
+			r.names[field] = rule
+		}
+	}
+	for _, header := range cfg.Headers {
+		r.headers[http.CanonicalHeaderKey(header)] = true
+	}
+	for name, rule := range cfg.Query {
+		r.query[strings.ToLower(name)] = rule
+	}
+	return r
+}
+
+// JSON 解析 JSON 并对敏感字段脱敏，返回脱敏后的数据，data 不是合法的 JSON 时返回 false
+//
+// 数字按 json.Number 解析，不会丢失精度
+func (r *Redactor) JSON(data []byte) (interface{}, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	return r.redactValue(v, ""), true
+}
+
+// Value 对任意可以序列化为 JSON 的值脱敏，如 response.APIResult，序列化失败时返回 nil
+func (r *Redactor) Value(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	redacted, _ := r.JSON(data)
+	return redacted
+}
+
+func (r *Redactor) redactValue(v interface{}, path string) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			childPath := joinPath(path, strings.ToLower(key))
+			if rule, ok := r.fieldRule(childPath, strings.ToLower(key)); ok {
+				val[key] = maskValue(child, rule)
+			} else {
+				val[key] = r.redactValue(child, childPath)
+			}
+		}
+	case []interface{}:
+		for i, child := range val {
+			val[i] = r.redactValue(child, path)
+		}
+	}
+	return v
+}
+
+func (r *Redactor) fieldRule(path, name string) (MaskRule, bool) {
+	if rule, ok := r.full[path]; ok {
+		return rule, true
+	}
+	rule, ok := r.names[name]
+	return rule, ok
+}
+
+// Query 对 query 字符串中的敏感参数脱敏
+func (r *Redactor) Query(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// 无法解析时不记录原值，避免泄露
+		return fullMask
+	}
+	changed := false
+	for name, vals := range values {
+		rule, ok := r.query[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+		for i := range vals {
+			vals[i] = Mask(vals[i], rule)
+		}
+		changed = true
+	}
+	if !changed {
+		return rawQuery
+	}
+	return values.Encode()
+}
+
+// Header 返回敏感请求头脱敏后的副本
+func (r *Redactor) Header(header http.Header) http.Header {
+	redacted := header.Clone()
+	for name := range redacted {
+		if r.headers[name] {
+			redacted[name] = []string{fullMask}
+		}
+	}
+	return redacted
+}
+
+// maskValue 字符串按规则脱敏，数字、对象等其他类型的敏感值整体替换
+func maskValue(v interface{}, rule MaskRule) interface{} {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return Mask(val, rule)
+	case []interface{}:
+		for i := range val {
+			val[i] = maskValue(val[i], rule)
+		}
+		return val
+	}
+	return fullMask
+}
+
+// Mask 按规则对字符串脱敏，空字符串原样返回
+func Mask(s string, rule MaskRule) string {
+	if s == "" {
+		return ""
+	}
+	switch rule {
+	case MaskEmail:
+		return maskEmail(s)
+	case MaskPhone:
+		return utils.MaskPhoneNumber(s)
+	case MaskIDCard:
+		return idcard.Mask(s)
+	}
+	return fullMask
+}
+
+// maskEmail 保留用户名首字符及域名，不是合法邮箱时整体替换
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return fullMask
+	}
+	local := []rune(email[:at])
+	return string(local[0]) + "***" + email[at:]
+}
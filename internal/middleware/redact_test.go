@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		rule     MaskRule
+		expected string
+	}{
+		{name: "整体掩码", value: "secret", rule: MaskFull, expected: "******"},
+		{name: "整体掩码不暴露长度", value: "a-very-long-secret", rule: MaskFull, expected: "******"},
+		{name: "邮箱", value: "tom@example.com", rule: MaskEmail, expected: "t***@example.com"},
+		{name: "中文用户名邮箱", value: "张三@example.com", rule: MaskEmail, expected: "张***@example.com"},
+		{name: "非法邮箱", value: "tom", rule: MaskEmail, expected: "******"},
+		{name: "手机号", value: "13812345678", rule: MaskPhone, expected: "138****5678"},
+		{name: "身份证号", value: "11010519491231002X", rule: MaskIDCard, expected: "1101**********002X"},
+		{name: "空字符串", value: "", rule: MaskFull, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Mask(tt.value, tt.rule))
+		})
+	}
+}
+
+func TestRedactorJSON(t *testing.T) {
+	r := NewRedactor(&RedactConfig{
+		Fields: map[string]MaskRule{
+			"password":     MaskFull,
+			"email":        MaskEmail,
+			"profile.bio":  MaskFull,
+			"contacts.tel": MaskPhone,
+		},
+	})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "任意层级字段名",
+			input:    `{"username":"tom","Password":"123456","nested":{"password":123}}`,
+			expected: `{"Password":"******","nested":{"password":"******"},"username":"tom"}`,
+		},
+		{
+			name:     "完整路径只匹配对应层级",
+			input:    `{"bio":"a","profile":{"bio":"b"}}`,
+			expected: `{"bio":"a","profile":{"bio":"******"}}`,
+		},
+		{
+			name:     "数组中的对象",
+			input:    `{"contacts":[{"tel":"13812345678"},{"tel":"13900001111"}]}`,
+			expected: `{"contacts":[{"tel":"138****5678"},{"tel":"139****1111"}]}`,
+		},
+		{
+			name:     "部分掩码及大整数",
+			input:    `{"email":"tom@example.com","id":12345678901234567890}`,
+			expected: `{"email":"t***@example.com","id":12345678901234567890}`,
+		},
+		{
+			name:     "null保持不变",
+			input:    `{"password":null}`,
+			expected: `{"password":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := r.JSON([]byte(tt.input))
+			assert.True(t, ok)
+			data, _ := json.Marshal(got)
+			assert.Equal(t, tt.expected, string(data))
+		})
+	}
+
+	_, ok := r.JSON([]byte(`{"password":`))
+	assert.False(t, ok)
+}
+
+func TestRedactorQueryAndHeader(t *testing.T) {
+	r := NewRedactor(nil)
+
+	assert.Equal(t, "page=1", r.Query("page=1"))
+	assert.Equal(t, "page=1&phone=138%2A%2A%2A%2A5678&token=%2A%2A%2A%2A%2A%2A", r.Query("page=1&phone=13812345678&token=abc"))
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer abc")
+	header.Set("X-Refresh-Token", "xyz")
+	header.Set("User-Agent", "curl")
+	redacted := r.Header(header)
+	assert.Equal(t, "******", redacted.Get("Authorization"))
+	assert.Equal(t, "******", redacted.Get("X-Refresh-Token"))
+	assert.Equal(t, "curl", redacted.Get("User-Agent"))
+	assert.Equal(t, "Bearer abc", header.Get("Authorization"), "原始请求头不应被修改")
+}
+
+func TestReadLogBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		maxSize     int64
+		expected    interface{}
+	}{
+		{
+			name:        "JSON脱敏",
+			contentType: "application/json",
+			body:        `{"username":"tom","password":"123456"}`,
+			maxSize:     1024,
+			expected:    map[string]interface{}{"username": "tom", "password": "******"},
+		},
+		{
+			name:        "表单脱敏",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "username=tom&password=123456",
+			maxSize:     1024,
+			expected:    "password=%2A%2A%2A%2A%2A%2A&username=tom",
+		},
+		{
+			name:        "超过大小限制",
+			contentType: "application/json",
+			body:        `{"password":"123456"}`,
+			maxSize:     8,
+			expected:    "[请求体超过大小限制]",
+		},
+		{
+			name:        "非法JSON不记录原文",
+			contentType: "application/json",
+			body:        `{"password":"123456"`,
+			maxSize:     1024,
+			expected:    "[非法的 JSON 请求体]",
+		},
+		{
+			name:        "multipart不读取",
+			contentType: "multipart/form-data; boundary=x",
+			body:        "--x--",
+			maxSize:     1024,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", tt.contentType)
+
+			got := readLogBody(c, tt.maxSize, NewRedactor(nil))
+			assert.Equal(t, tt.expected, got)
+
+			// 读取后请求体应完整保留给后续处理
+			body, _ := io.ReadAll(c.Request.Body)
+			assert.Equal(t, tt.body, string(body))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"godemo/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := config.SecurityHeadersConfig{
+		HSTSMaxAge:            365 * 24 * time.Hour,
+		HSTSIncludeSubdomains: true,
+		ContentSecurityPolicy: "default-src 'none'",
+		ContentTypeNosniff:    true,
+		ReferrerPolicy:        "no-referrer",
+		FrameOptions:          "sameorigin",
+	}
+	router := gin.New()
+	router.Use(securityHeaders(func() config.SecurityHeadersConfig { return cfg }))
+	router.GET("/test", func(c *gin.Context) { c.AbortWithStatus(http.StatusForbidden) })
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "max-age=31536000; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+	assert.Equal(t, "default-src 'none'", w.Header().Get("Content-Security-Policy"))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "no-referrer", w.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "SAMEORIGIN", w.Header().Get("X-Frame-Options"))
+
+	// 未配置的项不设置
+	cfg = config.SecurityHeadersConfig{}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+	for _, name := range []string{"Strict-Transport-Security", "Content-Security-Policy", "X-Content-Type-Options", "Referrer-Policy", "X-Frame-Options"} {
+		assert.Empty(t, w.Header().Get(name), name)
+	}
+}
+
+func TestIPFilter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	cfg := config.SecurityConfig{IPRules: map[string]config.IPRuleConfig{
+		"system":  {Allow: []string{"10.0.0.0/8", "127.0.0.1"}, Deny: []string{"10.0.0.13"}},
+		"partner": {Deny: []string{"1.2.3.0/24"}},
+	}}
+	router := gin.New()
+	require.NoError(t, router.SetTrustedProxies([]string{"172.16.0.1"}))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/health/check", ipFilter("system", func() config.SecurityConfig { return cfg }), ok)
+	router.GET("/partner", ipFilter("partner", func() config.SecurityConfig { return cfg }), ok)
+	router.GET("/users", ipFilter("users", func() config.SecurityConfig { return cfg }), ok)
+
+	tests := []struct {
+		name         string
+		path         string
+		remoteAddr   string
+		forwardedFor string
+		wantStatus   int
+	}{
+		{name: "allow 中的 IP", path: "/health/check", remoteAddr: "10.1.2.3:1234", wantStatus: http.StatusOK},
+		{name: "不在 allow 中", path: "/health/check", remoteAddr: "1.2.3.4:1234", wantStatus: http.StatusForbidden},
+		{name: "deny 优先", path: "/health/check", remoteAddr: "10.0.0.13:1234", wantStatus: http.StatusForbidden},
+		{name: "可信代理转发", path: "/health/check", remoteAddr: "172.16.0.1:1234", forwardedFor: "10.1.2.3", wantStatus: http.StatusOK},
+		{name: "可信代理转发外网 IP", path: "/health/check", remoteAddr: "172.16.0.1:1234", forwardedFor: "1.2.3.4", wantStatus: http.StatusForbidden},
+		{name: "非可信代理伪造 X-Forwarded-For", path: "/health/check", remoteAddr: "1.2.3.4:1234", forwardedFor: "127.0.0.1", wantStatus: http.StatusForbidden},
+		{name: "只配置 deny", path: "/partner", remoteAddr: "5.6.7.8:1234", wantStatus: http.StatusOK},
+		{name: "deny 中的网段", path: "/partner", remoteAddr: "1.2.3.4:1234", wantStatus: http.StatusForbidden},
+		{name: "未配置的分组", path: "/users", remoteAddr: "1.2.3.4:1234", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), `"code":1018`)
+			}
+		})
+	}
+}
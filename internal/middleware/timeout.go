@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"godemo/config"
+	"godemo/internal/apperr"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Timeout 按路由分组设置请求的处理超时，超时时间从 BusinessConfig 中实时读取，热更新后对新请求生效
+//
+// 超时通过 context 的 deadline 传递到仓储层的 DB 及 Redis 调用，调用因超时失败时由 apperr.Render 输出 504；
+// handler 没有输出响应时同样返回 504。请求在处理前已被取消时返回 503
+func Timeout(group string) gin.HandlerFunc {
+	return timeout(func() time.Duration {
+		return config.BusinessCfg.TimeoutConfig().For(group)
+	})
+}
+
+func timeout(duration func() time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		if err := ctx.Err(); err != nil {
+			apperr.Abort(c, apperr.ErrUnavailable.Wrap(err))
+			return
+		}
+		d := duration()
+		if d <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if !c.Writer.Written() && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			apperr.Render(c, apperr.ErrTimeout.Wrap(ctx.Err()))
+		}
+	}
+}
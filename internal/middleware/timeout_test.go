@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"godemo/internal/apperr"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	tests := []struct {
+		name           string
+		timeout        time.Duration
+		canceled       bool // 请求在处理前已被取消
+		handler        gin.HandlerFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:    "未超时",
+			timeout: time.Second,
+			handler: func(c *gin.Context) {
+				_, ok := c.Request.Context().Deadline()
+				assert.True(t, ok)
+				c.Status(http.StatusOK)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "仓储调用超时",
+			timeout: 10 * time.Millisecond,
+			handler: func(c *gin.Context) {
+				<-c.Request.Context().Done()
+				apperr.Render(c, apperr.ErrInternal.Wrap(c.Request.Context().Err()))
+			},
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCode:   `"code":1011`,
+		},
+		{
+			name:    "超时后没有输出响应",
+			timeout: 10 * time.Millisecond,
+			handler: func(c *gin.Context) {
+				<-c.Request.Context().Done()
+			},
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCode:   `"code":1011`,
+		},
+		{
+			name:     "请求已取消",
+			timeout:  time.Second,
+			canceled: true,
+			handler: func(c *gin.Context) {
+				t.Error("请求已取消时不应执行 handler")
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedCode:   `"code":1012`,
+		},
+		{
+			name:    "超时为0时不设置deadline",
+			timeout: 0,
+			handler: func(c *gin.Context) {
+				_, ok := c.Request.Context().Deadline()
+				assert.False(t, ok)
+				c.Status(http.StatusOK)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/test", timeout(func() time.Duration { return tt.timeout }), tt.handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.canceled {
+				ctx, cancel := context.WithCancel(req.Context())
+				cancel()
+				req = req.WithContext(ctx)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedCode != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedCode)
+			}
+		})
+	}
+}
@@ -3,65 +3,453 @@ package middleware
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
+	"mime"
+	"mime/multipart"
+	"reflect"
 	"strings"
 
+	"godemo/internal/apperr"
+
 	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/safego"
+)
+
+// TrimConfig TrimMiddleware 配置
+type TrimConfig struct {
+	// SkipPaths 不做 trim 的路由，可以是注册时的路由如 /api/v1/users/:id，也可以是请求路径
+	SkipPaths []string
+	// SkipFields 不做 trim 的字段
+	//
+	// 不含点号时匹配任意层级的同名字段，如 password；含点号时匹配从根开始的完整路径，如 profile.nickname，数组下标不计入路径。
+	// query 和 form 参数按参数名匹配
+	SkipFields []string
+	// SkipStructs 从结构体的 trim:"-" tag 中收集不做 trim 的字段，字段名取 json tag，没有时取 form tag
+	SkipStructs []interface{}
+	// MaxBodySize JSON 及 urlencoded 请求体、multipart 单个文本字段的最大字节数，超过时返回 413，0 表示不限制
+	//
+	// multipart 的文件部分不做 trim 也不读入内存，不受此限制
+	MaxBodySize int64
+}
+
+// gin.Context 中保存改写前的请求体及 query 的 key，用于校验请求签名，见 originalRequest
+const (
+	ctxRawBody  = "trim_raw_body"
+	ctxRawQuery = "trim_raw_query"
 )
 
-// JSON 的递归 trim
-func trimJSON(data interface{}) interface{} {
-	switch v := data.(type) {
-	case string:
-		return strings.TrimSpace(v)
-	case []interface{}:
-		for i := range v {
-			v[i] = trimJSON(v[i])
-		}
-		return v
-	case map[string]interface{}:
-		for k, val := range v {
-			v[k] = trimJSON(val)
-		}
-		return v
+// DefaultMaxBodySize 默认的请求体大小上限，与 net/http 解析 urlencoded 表单时的上限一致
+const DefaultMaxBodySize = 10 << 20
+
+// DefaultTrimConfig 默认配置，密码字段首尾的空格可能是有意设置的，不做 trim
+func DefaultTrimConfig() *TrimConfig {
+	return &TrimConfig{
+		SkipFields:  []string{"password"},
+		MaxBodySize: DefaultMaxBodySize,
+	}
+}
+
+// trimmer 根据配置决定哪些字段需要 trim
+type trimmer struct {
+	maxBodySize int64
+	skipPaths   map[string]bool
+	skipNames   map[string]bool // 任意层级的字段名
+	skipFull    map[string]bool // 从根开始的完整路径
+}
+
+func newTrimmer(cfg *TrimConfig) *trimmer {
+	t := &trimmer{
+		maxBodySize: cfg.MaxBodySize,
+		skipPaths:   make(map[string]bool),
+		skipNames:   make(map[string]bool),
+		skipFull:    make(map[string]bool),
+	}
+	for _, path := range cfg.SkipPaths {
+		t.skipPaths[path] = true
+	}
+	fields := append([]string{}, cfg.SkipFields...)
+	for _, s := range cfg.SkipStructs {
+		fields = append(fields, SkipFieldsFromStruct(s)...)
+	}
+	for _, field := range fields {
+		if strings.Contains(field, ".") {
+			t.skipFull[field] = true
+		} else {
+			t.skipNames[field] = true
+		}
+	}
+	return t
+}
+
+// skipField 判断 path 对应的字段是否不做 trim，name 为路径的最后一段
+func (t *trimmer) skipField(path, name string) bool {
+	return t.skipNames[name] || t.skipFull[path]
+}
+
+// trimJSON trim JSON 中的字符串值，返回新的 body 及是否有值发生变化
+//
+// 只替换需要 trim 的字符串，数字、字段顺序、空白及未变化字符串的转义写法都按原样保留，
+// 避免超过 2^53 的整数经过 float64 后失真。src 不是合法的 JSON 时原样返回
+func (t *trimmer) trimJSON(src []byte) ([]byte, bool) {
+	if !json.Valid(src) {
+		return src, false
+	}
+	r := &jsonRewriter{trimmer: t, src: src, out: make([]byte, 0, len(src))}
+	r.value("", false)
+	r.copyRest()
+	if !r.changed {
+		return src, false
+	}
+	return r.out, true
+}
+
+// jsonRewriter 逐个 token 扫描合法的 JSON，把扫描过的字节写入 out
+type jsonRewriter struct {
+	*trimmer
+	src     []byte
+	out     []byte
+	pos     int
+	changed bool
+}
+
+// value 处理一个 JSON 值，path 为值的字段路径，skip 表示该值及其子字段都不做 trim
+func (r *jsonRewriter) value(path string, skip bool) {
+	r.whitespace()
+	switch r.src[r.pos] {
+	case '{':
+		r.copyByte()
+		for {
+			r.whitespace()
+			if r.src[r.pos] == '}' {
+				r.copyByte()
+				return
+			}
+			if r.src[r.pos] == ',' {
+				r.copyByte()
+				r.whitespace()
+			}
+			raw := r.stringToken()
+			r.out = append(r.out, raw...)
+			var key string
+			_ = json.Unmarshal(raw, &key)
+			r.whitespace()
+			r.copyByte() // 冒号
+			childPath := joinPath(path, key)
+			r.value(childPath, skip || r.skipField(childPath, key))
+		}
+	case '[':
+		r.copyByte()
+		for {
+			r.whitespace()
+			if r.src[r.pos] == ']' {
+				r.copyByte()
+				return
+			}
+			if r.src[r.pos] == ',' {
+				r.copyByte()
+			}
+			r.value(path, skip)
+		}
+	case '"':
+		raw := r.stringToken()
+		r.out = append(r.out, r.trimString(raw, skip)...)
 	default:
-		return v
+		// 数字、true、false、null 原样保留
+		start := r.pos
+		for r.pos < len(r.src) && !strings.ContainsRune(",]} \t\r\n", rune(r.src[r.pos])) {
+			r.pos++
+		}
+		r.out = append(r.out, r.src[start:r.pos]...)
+	}
+}
+
+// trimString 返回 trim 后的字符串 token，不需要变化时返回原始字节
+func (r *jsonRewriter) trimString(raw []byte, skip bool) []byte {
+	if skip {
+		return raw
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return raw
 	}
+	trimmed := strings.TrimSpace(s)
+	if trimmed == s {
+		return raw
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(trimmed)
+	r.changed = true
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// stringToken 读取一个字符串 token，包含首尾的引号
+func (r *jsonRewriter) stringToken() []byte {
+	start := r.pos
+	r.pos++ // 开头的引号
+	for r.src[r.pos] != '"' {
+		if r.src[r.pos] == '\\' {
+			r.pos++
+		}
+		r.pos++
+	}
+	r.pos++ // 结尾的引号
+	return r.src[start:r.pos]
+}
+
+func (r *jsonRewriter) whitespace() {
+	for r.pos < len(r.src) && strings.ContainsRune(" \t\r\n", rune(r.src[r.pos])) {
+		r.copyByte()
+	}
+}
+
+func (r *jsonRewriter) copyByte() {
+	r.out = append(r.out, r.src[r.pos])
+	r.pos++
+}
+
+func (r *jsonRewriter) copyRest() {
+	r.out = append(r.out, r.src[r.pos:]...)
+	r.pos = len(r.src)
+}
+
+// trimValues trim query 或 form 参数，返回是否有值发生变化
+func (t *trimmer) trimValues(values map[string][]string) bool {
+	changed := false
+	for k, vals := range values {
+		if t.skipField(k, k) {
+			continue
+		}
+		for i := range vals {
+			if trimmed := strings.TrimSpace(vals[i]); trimmed != vals[i] {
+				vals[i] = trimmed
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// SkipFieldsFromStruct 收集结构体中带有 trim:"-" tag 的字段路径，嵌套结构体的字段以点号连接
+func SkipFieldsFromStruct(s interface{}) []string {
+	return collectSkipFields(reflect.TypeOf(s), "")
 }
 
-// TrimMiddleware 中间件，用于trim请求的body、query、form数据
+func collectSkipFields(typ reflect.Type, prefix string) []string {
+	for typ != nil && (typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := tagName(field)
+		if name == "-" {
+			continue
+		}
+		path := prefix
+		// 匿名嵌入且没有指定名称的结构体，字段展开到上一层
+		if !field.Anonymous || name != field.Name {
+			path = joinPath(prefix, name)
+		}
+		if field.Tag.Get("trim") == "-" {
+			fields = append(fields, path)
+			continue
+		}
+		fields = append(fields, collectSkipFields(field.Type, path)...)
+	}
+	return fields
+}
+
+// tagName 返回字段在 json 或 form 中的名称
+func tagName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// originalRequest 返回 TrimMiddleware 改写前的请求体及 query，没有经过 TrimMiddleware 时 ok 为 false
+//
+// body 为 nil 表示请求体没有被改写，multipart 等请求体直接读取 c.Request.Body 即可
+func originalRequest(c *gin.Context) (body []byte, rawQuery string, ok bool) {
+	value, ok := c.Get(ctxRawQuery)
+	if !ok {
+		return nil, "", false
+	}
+	rawQuery, _ = value.(string)
+	if value, exists := c.Get(ctxRawBody); exists {
+		body, _ = value.([]byte)
+	}
+	return body, rawQuery, true
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// readBody 读取整个请求体，超过 maxBodySize 时返回 errBodyTooLarge
+func (t *trimmer) readBody(body io.Reader) ([]byte, error) {
+	if t.maxBodySize <= 0 {
+		return io.ReadAll(body)
+	}
+	data, err := io.ReadAll(io.LimitReader(body, t.maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > t.maxBodySize {
+		return nil, errBodyTooLarge
+	}
+	return data, nil
+}
+
+var errBodyTooLarge = errors.New("request body too large")
+
+// errMultipartPanic 改写 multipart 请求体时发生 panic，由 SafeGo 记录 panic 信息
+var errMultipartPanic = errors.New("trim multipart panic")
+
+// trimMultipart 边读边改写 multipart 请求体，只 trim 文本字段，文件部分原样透传，不会整体读入内存
 //
-// gin 的 shouldbindjson 会自动验证参数，所以需要在验证前全局 trim，之后再进行验证
-func TrimMiddleware() gin.HandlerFunc {
+// 改写在后台协程中通过 pipe 进行，返回的 stop 用于请求处理完成后关闭 pipe，
+// 避免 handler 没有读取请求体时后台协程一直阻塞
+func (t *trimmer) trimMultipart(c *gin.Context) (stop func(), ok bool) {
+	_, params, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || params["boundary"] == "" {
+		return nil, false
+	}
+	boundary := params["boundary"]
+
+	pr, pw := io.Pipe()
+	mr := multipart.NewReader(c.Request.Body, boundary)
+	go safego.SafeGo(c.Request.Context(), func() {
+		// 改写过程中 panic 时也要关闭 pipe，否则 handler 读取请求体会一直阻塞
+		err := errMultipartPanic
+		defer func() { pw.CloseWithError(err) }()
+		err = t.rewriteMultipart(mr, pw, boundary)
+	})
+
+	c.Request.Body = pr
+	c.Request.ContentLength = -1
+	return func() { _ = pr.Close() }, true
+}
+
+// rewriteMultipart 把 mr 中的各部分依次写入 w，保持原有的 boundary 和各部分的 header
+func (t *trimmer) rewriteMultipart(mr *multipart.Reader, w io.Writer, boundary string) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(boundary); err != nil {
+		return err
+	}
+	for {
+		// NextRawPart 不解码 quoted-printable，保证透传的内容与原始请求一致
+		part, err := mr.NextRawPart()
+		if err == io.EOF {
+			return mw.Close()
+		}
+		if err != nil {
+			return err
+		}
+		dst, err := mw.CreatePart(part.Header)
+		if err != nil {
+			return err
+		}
+
+		name := part.FormName()
+		if part.FileName() != "" || name == "" || t.skipField(name, name) {
+			_, err = io.Copy(dst, part)
+		} else {
+			var value []byte
+			if value, err = t.readBody(part); err == nil {
+				_, err = dst.Write(bytes.TrimSpace(value))
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// TrimMiddleware 中间件，用于trim请求的body、query、form数据，cfg 为 nil 时使用 DefaultTrimConfig
+//
+// gin 的 shouldbindjson 会自动验证参数，所以需要在验证前全局 trim，之后再进行验证。
+// JSON 及 urlencoded 请求体超过 MaxBodySize 时返回 413；multipart 请求只 trim 文本字段，文件部分流式透传。
+// 改写前的请求体及 query 保存在 context 中，签名校验使用客户端签名时的原始请求，见 originalRequest；
+// 带有签名的 multipart 请求不做 trim，避免为保存原始请求体把文件读入内存
+func TrimMiddleware(cfg *TrimConfig) gin.HandlerFunc {
+	if cfg == nil {
+		cfg = DefaultTrimConfig()
+	}
+	t := newTrimmer(cfg)
+
 	return func(c *gin.Context) {
+		if t.skipPaths[c.FullPath()] || t.skipPaths[c.Request.URL.Path] {
+			c.Next()
+			return
+		}
+
 		ct := c.ContentType()
+		isJSON := strings.Contains(ct, "application/json")
+		// 只有需要整体读入的请求体受 MaxBodySize 限制，声明的长度已超限时不再读取
+		if (isJSON || ct == gin.MIMEPOSTForm) && t.maxBodySize > 0 && c.Request.ContentLength > t.maxBodySize {
+			apperr.Abort(c, apperr.ErrTooLarge)
+			return
+		}
 
-		// 处理 JSON
-		if strings.Contains(ct, "application/json") {
-			body, err := io.ReadAll(c.Request.Body)
-			if err == nil && len(body) > 0 {
-				var tmp interface{}
-				if err := json.Unmarshal(body, &tmp); err == nil {
-					tmp = trimJSON(tmp)
-					newBody, _ := json.Marshal(tmp)
-					c.Request.Body = io.NopCloser(bytes.NewReader(newBody))
-					c.Request.ContentLength = int64(len(newBody))
-				} else {
-					// JSON 解析失败，恢复原 body
-					c.Request.Body = io.NopCloser(bytes.NewReader(body))
-				}
+		switch {
+		case isJSON:
+			body, err := t.readBody(c.Request.Body)
+			if errors.Is(err, errBodyTooLarge) {
+				apperr.Abort(c, apperr.ErrTooLarge)
+				return
+			}
+			if err == nil {
+				c.Set(ctxRawBody, body)
+				// JSON 解析失败或没有需要 trim 的值时 newBody 就是原 body
+				newBody, _ := t.trimJSON(body)
+				c.Request.Body = io.NopCloser(bytes.NewReader(newBody))
+				c.Request.ContentLength = int64(len(newBody))
+			}
+		case ct == gin.MIMEMultipartPOSTForm && c.GetHeader(SignatureHeader) == "":
+			if stop, ok := t.trimMultipart(c); ok {
+				defer stop()
+			}
+		case ct == gin.MIMEPOSTForm && c.Request.Body != nil:
+			// ParseForm 会读完请求体，先读出来保存原始请求体再交给 ParseForm
+			body, err := t.readBody(c.Request.Body)
+			if errors.Is(err, errBodyTooLarge) {
+				apperr.Abort(c, apperr.ErrTooLarge)
+				return
+			}
+			if err == nil {
+				c.Set(ctxRawBody, body)
+				c.Request.Body = io.NopCloser(bytes.NewReader(body))
 			}
 		}
 
-		// 处理 form / query
-		if err := c.Request.ParseForm(); err == nil {
-			for k, vals := range c.Request.Form {
-				for i := range vals {
-					vals[i] = strings.TrimSpace(vals[i])
-				}
-				c.Request.Form[k] = vals
-			}
-			c.Request.PostForm = c.Request.Form
+		// 处理 query，ShouldBindQuery 读取的是 URL.Query()，需要回写到 RawQuery
+		c.Set(ctxRawQuery, c.Request.URL.RawQuery)
+		if query := c.Request.URL.Query(); t.trimValues(query) {
+			c.Request.URL.RawQuery = query.Encode()
+		}
+
+		// 处理 form，ParseForm 只会读取 urlencoded 请求体，multipart 请求体留给后续的绑定流式解析
+		err := c.Request.ParseForm()
+		if err == nil {
+			t.trimValues(c.Request.Form)
+			t.trimValues(c.Request.PostForm)
 		}
 
 		c.Next()
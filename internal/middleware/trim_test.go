@@ -1,115 +1,57 @@
 package middleware
 
 import (
+	"bytes"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jessewkun/gocommon/logger"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestTrimJSON(t *testing.T) {
 	tests := []struct {
 		name     string
-		input    interface{}
-		expected interface{}
+		input    string
+		expected string
+		changed  bool
 	}{
+		{name: "字符串trim", input: `"  hello world  "`, expected: `"hello world"`, changed: true},
+		{name: "空字符串", input: `""`, expected: `""`},
+		{name: "只有空格", input: `"   "`, expected: `""`, changed: true},
+		{name: "数字类型", input: `123`, expected: `123`},
+		{name: "布尔类型", input: `true`, expected: `true`},
+		{name: "nil值", input: `null`, expected: `null`},
+		{name: "字符串数组", input: `["  hello  ", "  world  ","test"]`, expected: `["hello", "world","test"]`, changed: true},
 		{
-			name:     "字符串trim",
-			input:    "  hello world  ",
-			expected: "hello world",
-		},
-		{
-			name:     "空字符串",
-			input:    "",
-			expected: "",
-		},
-		{
-			name:     "只有空格",
-			input:    "   ",
-			expected: "",
-		},
-		{
-			name:     "数字类型",
-			input:    123,
-			expected: 123,
-		},
-		{
-			name:     "布尔类型",
-			input:    true,
-			expected: true,
-		},
-		{
-			name:     "nil值",
-			input:    nil,
-			expected: nil,
-		},
-		{
-			name: "字符串数组",
-			input: []interface{}{
-				"  hello  ",
-				"  world  ",
-				"  test  ",
-			},
-			expected: []interface{}{
-				"hello",
-				"world",
-				"test",
-			},
-		},
-		{
-			name: "嵌套对象",
-			input: map[string]interface{}{
-				"name":   "  John Doe  ",
-				"email":  "  john@example.com  ",
-				"age":    30,
-				"active": true,
-				"tags":   []interface{}{"  tag1  ", "  tag2  "},
-				"address": map[string]interface{}{
-					"street": "  123 Main St  ",
-					"city":   "  New York  ",
-				},
-			},
-			expected: map[string]interface{}{
-				"name":   "John Doe",
-				"email":  "john@example.com",
-				"age":    30,
-				"active": true,
-				"tags":   []interface{}{"tag1", "tag2"},
-				"address": map[string]interface{}{
-					"street": "123 Main St",
-					"city":   "New York",
-				},
-			},
-		},
-		{
-			name: "混合类型数组",
-			input: []interface{}{
-				"  string  ",
-				123,
-				true,
-				map[string]interface{}{
-					"key": "  value  ",
-				},
-			},
-			expected: []interface{}{
-				"string",
-				123,
-				true,
-				map[string]interface{}{
-					"key": "value",
-				},
-			},
+			name:     "嵌套对象保留字段顺序和格式",
+			input:    "{\n  \"name\": \"  John Doe  \",\n  \"age\": 30,\n  \"active\": true,\n  \"tags\": [\"  tag1  \", \"tag2\"],\n  \"address\": {\"street\": \"  123 Main St  \", \"city\": \"New York\"}\n}",
+			expected: "{\n  \"name\": \"John Doe\",\n  \"age\": 30,\n  \"active\": true,\n  \"tags\": [\"tag1\", \"tag2\"],\n  \"address\": {\"street\": \"123 Main St\", \"city\": \"New York\"}\n}",
+			changed:  true,
 		},
+		{name: "超过2^53的整数", input: `{"id": 9007199254740993, "name": " a "}`, expected: `{"id": 9007199254740993, "name": "a"}`, changed: true},
+		{name: "int64最大值", input: `{"id":9223372036854775807,"ids":[18446744073709551615, -9223372036854775808]}`, expected: `{"id":9223372036854775807,"ids":[18446744073709551615, -9223372036854775808]}`},
+		{name: "高精度小数", input: `{"amount": 0.10000000000000000555, "rate": 1.0, "e": 1E+400, "name": " a "}`, expected: `{"amount": 0.10000000000000000555, "rate": 1.0, "e": 1E+400, "name": "a"}`, changed: true},
+		{name: "负数和指数", input: `[-0, 1.5e-10, -2E3]`, expected: `[-0, 1.5e-10, -2E3]`},
+		{name: "未变化的转义保持原样", input: `{"html":"\u003cb\u003e","path":"a\/b"}`, expected: `{"html":"\u003cb\u003e","path":"a\/b"}`},
+		{name: "trim后的字符串不转义HTML", input: `{"html":" <b>&</b> "}`, expected: `{"html":"<b>&</b>"}`, changed: true},
+		{name: "转义的空白字符", input: `{"text":"\t hello \n","quote":" say \"hi\" "}`, expected: `{"text":"hello","quote":"say \"hi\""}`, changed: true},
+		{name: "跳过的字段", input: `{"password":"  pwd  ","user":{"password":[" a "]}}`, expected: `{"password":"  pwd  ","user":{"password":[" a "]}}`},
+		{name: "无效JSON", input: `{"name": "  John  "`, expected: `{"name": "  John  "`},
 	}
 
+	tr := newTrimmer(DefaultTrimConfig())
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := trimJSON(tt.input)
-			assert.Equal(t, tt.expected, result)
+			result, changed := tr.trimJSON([]byte(tt.input))
+			assert.Equal(t, tt.expected, string(result))
+			assert.Equal(t, tt.changed, changed)
 		})
 	}
 }
@@ -141,7 +83,15 @@ func TestTrimMiddleware(t *testing.T) {
 					"city": "  New York  "
 				}
 			}`,
-			expectedBody: `{"address":{"city":"New York","street":"123 Main St"},"email":"john@example.com","name":"John Doe","tags":["tag1","tag2"]}`,
+			expectedBody: `{
+				"name": "John Doe",
+				"email": "john@example.com",
+				"tags": ["tag1", "tag2"],
+				"address": {
+					"street": "123 Main St",
+					"city": "New York"
+				}
+			}`,
 		},
 		{
 			name:        "JSON请求空值",
@@ -152,7 +102,11 @@ func TestTrimMiddleware(t *testing.T) {
 				"email": "",
 				"description": null
 			}`,
-			expectedBody: `{"description":null,"email":"","name":""}`,
+			expectedBody: `{
+				"name": "",
+				"email": "",
+				"description": null
+			}`,
 		},
 		{
 			name:         "JSON请求无效JSON",
@@ -204,7 +158,7 @@ func TestTrimMiddleware(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建测试路由
 			router := gin.New()
-			router.Use(TrimMiddleware())
+			router.Use(TrimMiddleware(nil))
 
 			// 创建测试处理器来捕获处理后的数据
 			var capturedBody string
@@ -293,7 +247,7 @@ func TestTrimMiddlewareEdgeCases(t *testing.T) {
 
 	t.Run("空Content-Type", func(t *testing.T) {
 		router := gin.New()
-		router.Use(TrimMiddleware())
+		router.Use(TrimMiddleware(nil))
 
 		router.POST("/test", func(c *gin.Context) {
 			c.JSON(200, gin.H{"status": "ok"})
@@ -308,7 +262,7 @@ func TestTrimMiddlewareEdgeCases(t *testing.T) {
 
 	t.Run("非JSON Content-Type", func(t *testing.T) {
 		router := gin.New()
-		router.Use(TrimMiddleware())
+		router.Use(TrimMiddleware(nil))
 
 		router.POST("/test", func(c *gin.Context) {
 			c.JSON(200, gin.H{"status": "ok"})
@@ -324,7 +278,7 @@ func TestTrimMiddlewareEdgeCases(t *testing.T) {
 
 	t.Run("ParseForm错误", func(t *testing.T) {
 		router := gin.New()
-		router.Use(TrimMiddleware())
+		router.Use(TrimMiddleware(nil))
 
 		router.POST("/test", func(c *gin.Context) {
 			c.JSON(200, gin.H{"status": "ok"})
@@ -341,28 +295,274 @@ func TestTrimMiddlewareEdgeCases(t *testing.T) {
 	})
 }
 
-// 基准测试
-func BenchmarkTrimJSON(b *testing.B) {
-	data := map[string]interface{}{
-		"name":  "  John Doe  ",
-		"email": "  john@example.com  ",
-		"tags":  []interface{}{"  tag1  ", "  tag2  "},
-		"address": map[string]interface{}{
-			"street": "  123 Main St  ",
-			"city":   "  New York  ",
+func TestTrimMiddlewarePolicies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type profile struct {
+		Nickname string `json:"nickname" trim:"-"`
+		Bio      string `json:"bio"`
+	}
+	type request struct {
+		Signature string    `json:"signature" trim:"-"`
+		Profile   profile   `json:"profile"`
+		Items     []profile `json:"items"`
+	}
+
+	tests := []struct {
+		name         string
+		cfg          *TrimConfig
+		path         string
+		body         string
+		expectedBody string
+	}{
+		{
+			name:         "默认不trim密码",
+			path:         "/api/v1/users",
+			body:         `{"username":"  tom  ","password":"  secret  ","profile":{"password":" x "}}`,
+			expectedBody: `{"username":"tom","password":"  secret  ","profile":{"password":" x "}}`,
+		},
+		{
+			name:         "跳过路由",
+			cfg:          &TrimConfig{SkipPaths: []string{"/api/v1/posts/:id"}},
+			path:         "/api/v1/posts/1",
+			body:         `{"content":"  padded  "}`,
+			expectedBody: `{"content":"  padded  "}`,
+		},
+		{
+			name:         "完整路径只匹配对应层级",
+			cfg:          &TrimConfig{SkipFields: []string{"profile.bio"}},
+			path:         "/api/v1/users",
+			body:         `{"bio":"  a  ","profile":{"bio":"  b  "}}`,
+			expectedBody: `{"bio":"a","profile":{"bio":"  b  "}}`,
+		},
+		{
+			name:         "结构体tag",
+			cfg:          &TrimConfig{SkipStructs: []interface{}{request{}}},
+			path:         "/api/v1/users",
+			body:         `{"signature":"  s  ","profile":{"nickname":"  n  ","bio":"  b  "},"items":[{"nickname":"  n  ","bio":"  b  "}]}`,
+			expectedBody: `{"signature":"  s  ","profile":{"nickname":"  n  ","bio":"b"},"items":[{"nickname":"  n  ","bio":"b"}]}`,
 		},
 	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(TrimMiddleware(tt.cfg))
+
+			var capturedBody string
+			handler := func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				capturedBody = string(body)
+				c.Status(http.StatusOK)
+			}
+			router.POST("/api/v1/users", handler)
+			router.POST("/api/v1/posts/:id", handler)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.expectedBody, capturedBody)
+		})
+	}
+}
+
+func TestTrimMiddlewareBindQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type query struct {
+		Keyword  string `form:"keyword"`
+		Password string `form:"password"`
+		Page     int    `form:"page"`
+	}
+
+	router := gin.New()
+	router.Use(TrimMiddleware(nil))
+
+	var got query
+	router.GET("/test", func(c *gin.Context) {
+		assert.NoError(t, c.ShouldBindQuery(&got))
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test?keyword=%20%20tom%20%20&password=%20pwd%20&page=%202%20", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, query{Keyword: "tom", Password: " pwd ", Page: 2}, got)
+}
+
+func TestTrimMiddlewareBodyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           string
+		chunked        bool // 不设置 Content-Length，只能在读取时发现超限
+		expectedStatus int
+	}{
+		{
+			name:           "JSON未超限",
+			contentType:    "application/json",
+			body:           `{"a":" 1 "}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "JSON超限",
+			contentType:    "application/json",
+			body:           `{"a":"` + strings.Repeat("x", 32) + `"}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "JSON未声明长度时超限",
+			contentType:    "application/json",
+			body:           `{"a":"` + strings.Repeat("x", 32) + `"}`,
+			chunked:        true,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "urlencoded未声明长度时超限",
+			contentType:    "application/x-www-form-urlencoded",
+			body:           "a=" + strings.Repeat("x", 32),
+			chunked:        true,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(TrimMiddleware(&TrimConfig{MaxBodySize: 16}))
+			router.POST("/test", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusRequestEntityTooLarge {
+				assert.Contains(t, w.Body.String(), `"code":1008`)
+			}
+		})
+	}
+}
+
+func TestTrimMiddlewareMultipart(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	_ = mw.WriteField("username", "  tom  ")
+	_ = mw.WriteField("password", "  secret  ")
+	file, _ := mw.CreateFormFile("avatar", "avatar.txt")
+	// 文件内容超过 MaxBodySize 且首尾有空白，应原样透传
+	fileContent := "  " + strings.Repeat("x", 64) + "  "
+	_, _ = file.Write([]byte(fileContent))
+	_ = mw.Close()
+
+	router := gin.New()
+	router.Use(TrimMiddleware(&TrimConfig{SkipFields: []string{"password"}, MaxBodySize: 16}))
+
+	var username, password, uploaded string
+	router.POST("/test", func(c *gin.Context) {
+		username = c.PostForm("username")
+		password = c.PostForm("password")
+		header, err := c.FormFile("avatar")
+		if assert.NoError(t, err) {
+			f, _ := header.Open()
+			data, _ := io.ReadAll(f)
+			uploaded = string(data)
+		}
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/test", &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "tom", username)
+	assert.Equal(t, "  secret  ", password)
+	assert.Equal(t, fileContent, uploaded)
+}
+
+// panicReader 读取时 panic，模拟改写 multipart 请求体时发生 panic
+type panicReader struct{}
+
+func (panicReader) Read([]byte) (int, error) { panic("read body") }
+
+func TestTrimMiddlewareMultipartPanic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Cfg.Closed = true
+
+	router := gin.New()
+	router.Use(TrimMiddleware(nil))
+
+	var readErr error
+	router.POST("/test", func(c *gin.Context) {
+		_, readErr = io.ReadAll(c.Request.Body)
+		c.Status(http.StatusBadRequest)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/test", panicReader{})
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=xxx")
+	w := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		router.ServeHTTP(w, req)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler blocked on request body")
+	}
+	assert.ErrorIs(t, readErr, errMultipartPanic)
+}
+
+func TestSkipFieldsFromStruct(t *testing.T) {
+	type Embedded struct {
+		Token string `json:"token" trim:"-"`
+	}
+	type request struct {
+		Embedded
+		Password string `json:"password,omitempty" trim:"-"`
+		Code     string `form:"code" trim:"-"`
+		Ignored  string `json:"-" trim:"-"`
+		Nested   *struct {
+			Secret string `json:"secret" trim:"-"`
+		} `json:"nested"`
+		Name string `json:"name"`
+	}
+
+	assert.Equal(t, []string{"token", "password", "code", "nested.secret"}, SkipFieldsFromStruct(request{}))
+}
+
+// 基准测试
+func BenchmarkTrimJSON(b *testing.B) {
+	data := []byte(`{"name":"  John Doe  ","email":"  john@example.com  ","tags":["  tag1  ","  tag2  "],"address":{"street":"  123 Main St  ","city":"  New York  "}}`)
+
+	t := newTrimmer(DefaultTrimConfig())
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		trimJSON(data)
+		t.trimJSON(data)
 	}
 }
 
 func BenchmarkTrimMiddleware(b *testing.B) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
-	router.Use(TrimMiddleware())
+	router.Use(TrimMiddleware(nil))
 
 	router.POST("/test", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
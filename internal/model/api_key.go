@@ -0,0 +1,55 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jessewkun/gocommon/db/mysql"
+	"gorm.io/gorm"
+)
+
+// APIKey 合作方服务端调用使用的 API Key
+//
+// 不保存 secret，secret 由服务端配置的 pepper 与 KeyID、Salt 派生，pepper 不保存在数据库中，
+// 只读取数据库无法得到 secret 伪造签名
+type APIKey struct {
+	mysql.BaseModel
+	KeyID      string         `gorm:"size:32;uniqueIndex" json:"key_id"` // 公开的 key 标识，请求时放在 X-Api-Key 头中
+	Name       string         `gorm:"size:64" json:"name"`               // 名称，一般为合作方名称
+	Salt       string         `gorm:"size:64" json:"-"`                  // 派生 secret 的随机盐
+	Scopes     string         `gorm:"size:255" json:"scopes"`            // 授权范围，多个以逗号分隔，* 表示全部
+	ExpiresAt  mysql.DateTime `gorm:"type:datetime" json:"expires_at"`   // 过期时间，为空时不过期
+	LastUsedAt mysql.DateTime `gorm:"type:datetime" json:"last_used_at"` // 最后一次调用时间
+	RevokedAt  mysql.DateTime `gorm:"type:datetime" json:"revoked_at"`   // 停用时间
+}
+
+func (m *APIKey) BeforeCreate(tx *gorm.DB) (err error) {
+	now := mysql.DateTime(time.Now())
+	m.CreatedAt = now
+	m.ModifiedAt = now
+	return err
+}
+
+func (m *APIKey) BeforeUpdate(tx *gorm.DB) (err error) {
+	m.ModifiedAt = mysql.DateTime(time.Now())
+	return nil
+}
+
+// Active 在 now 时 API Key 是否可用
+func (m *APIKey) Active(now time.Time) bool {
+	if !time.Time(m.RevokedAt).IsZero() {
+		return false
+	}
+	expiresAt := time.Time(m.ExpiresAt)
+	return expiresAt.IsZero() || now.Before(expiresAt)
+}
+
+// HasScope 是否拥有授权范围
+func (m *APIKey) HasScope(scope string) bool {
+	for _, s := range strings.Split(m.Scopes, ",") {
+		if s = strings.TrimSpace(s); s == "*" || s == scope {
+			return true
+		}
+	}
+	return false
+}
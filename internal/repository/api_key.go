@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"godemo/internal/model"
+	"godemo/internal/wire/provider"
+
+	"github.com/jessewkun/gocommon/db/mysql"
+	"gorm.io/gorm"
+)
+
+// APIKeyRepository API Key 仓储接口
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *model.APIKey) error
+	FindByKeyID(ctx context.Context, keyID string) (*model.APIKey, error)
+	List(ctx context.Context) ([]*model.APIKey, error)
+	Revoke(ctx context.Context, keyID string, at time.Time) (bool, error)
+	TouchLastUsed(ctx context.Context, id int, at time.Time) error
+}
+
+// apiKeyRepository API Key 仓储实现
+type apiKeyRepository struct {
+	db provider.MainDB // 主库
+}
+
+// NewAPIKeyRepository 创建 API Key 仓储
+func NewAPIKeyRepository(db provider.MainDB) APIKeyRepository {
+	return &apiKeyRepository{
+		db: db,
+	}
+}
+
+// Create 创建 API Key
+func (r *apiKeyRepository) Create(ctx context.Context, key *model.APIKey) error {
+	return wrapDuplicateKey(r.db.WithContext(ctx).Create(key).Error)
+}
+
+// FindByKeyID 根据 key 标识查询，不存在时返回 nil
+func (r *apiKeyRepository) FindByKeyID(ctx context.Context, keyID string) (*model.APIKey, error) {
+	var key model.APIKey
+	err := r.db.WithContext(ctx).Where("key_id = ?", keyID).First(&key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// List 获取全部 API Key
+func (r *apiKeyRepository) List(ctx context.Context) ([]*model.APIKey, error) {
+	var keys []*model.APIKey
+	if err := r.db.WithContext(ctx).Order("id").Find(&keys).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Revoke 停用 API Key，key 不存在或已停用时返回 false
+func (r *apiKeyRepository) Revoke(ctx context.Context, keyID string, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.APIKey{}).
+		Where("key_id = ? AND revoked_at IS NULL", keyID).
+		Updates(map[string]interface{}{"revoked_at": mysql.DateTime(at), "modified_at": mysql.DateTime(at)})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TouchLastUsed 更新最后一次调用时间
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id int, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", mysql.DateTime(at)).Error
+}
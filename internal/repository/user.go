@@ -2,13 +2,63 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"godemo/internal/model"
 	"godemo/internal/wire/provider"
+	"regexp"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/jessewkun/gocommon/logger"
 	"gorm.io/gorm"
 )
 
+// ErrDuplicateKey 违反唯一约束，具体的索引见 DuplicateKeyError
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// MySQL 唯一约束冲突的错误号
+const mysqlErrDuplicateEntry = 1062
+
+// UserUsernameIndex 用户名的唯一索引
+const UserUsernameIndex = "uk_username"
+
+// DuplicateKeyError 违反唯一约束的错误，errors.Is(err, ErrDuplicateKey) 为 true
+type DuplicateKeyError struct {
+	Index string // 冲突的索引名称，无法从错误中解析时为空
+	Err   error
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("%v on index %q: %v", ErrDuplicateKey, e.Index, e.Err)
+}
+
+func (e *DuplicateKeyError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DuplicateKeyError) Is(target error) bool {
+	return target == ErrDuplicateKey
+}
+
+// duplicateEntryIndex 匹配 MySQL 1062 错误信息中的索引名称，如 Duplicate entry 'tom' for key 'users.uk_username'
+var duplicateEntryIndex = regexp.MustCompile(`for key '(?:[^']*\.)?([^'.]+)'$`)
+
+// wrapDuplicateKey 唯一约束冲突时转换为 DuplicateKeyError，其他错误原样返回
+func wrapDuplicateKey(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &DuplicateKeyError{Err: err}
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry {
+		dup := &DuplicateKeyError{Err: err}
+		if m := duplicateEntryIndex.FindStringSubmatch(mysqlErr.Message); m != nil {
+			dup.Index = m[1]
+		}
+		return dup
+	}
+	return err
+}
+
 // UserRepository 用户仓储接口
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
@@ -31,13 +81,13 @@ func NewUserRepository(db provider.MainDB) UserRepository {
 
 // Create 创建用户
 func (r *userRepository) Create(ctx context.Context, user *model.User) error {
-	return r.db.DB.Create(user).Error
+	return wrapDuplicateKey(r.db.WithContext(ctx).Create(user).Error)
 }
 
 // FindByID 根据ID查询用户
 func (r *userRepository) FindByID(ctx context.Context, id uint) (*model.User, error) {
 	var user model.User
-	err := r.db.DB.First(&user, id).Error
+	err := r.db.WithContext(ctx).First(&user, id).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -50,11 +100,14 @@ func (r *userRepository) FindByID(ctx context.Context, id uint) (*model.User, er
 	return &user, nil
 }
 
-// FindByUsername 根据用户名查询用户
+// FindByUsername 根据用户名查询用户，不存在时返回 nil
 func (r *userRepository) FindByUsername(ctx context.Context, username string) (*model.User, error) {
 	var user model.User
-	err := r.db.DB.Where("username = ?", username).First(&user).Error
+	err := r.db.WithContext(ctx).Where("username = ?", username).First(&user).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &user, nil
@@ -65,7 +118,7 @@ func (r *userRepository) List(ctx context.Context, offset, limit int, keyword st
 	var users []*model.User
 	var total int64
 
-	query := r.db.DB.Model(&model.User{})
+	query := r.db.WithContext(ctx).Model(&model.User{})
 	if keyword != "" {
 		query = query.Where("username LIKE ? OR email LIKE ?",
 			"%"+keyword+"%",
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestWrapDuplicateKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantDup   bool
+		wantIndex string
+	}{
+		{name: "MySQL 8 带表名", err: &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'tom' for key 'users.uk_username'"}, wantDup: true, wantIndex: "uk_username"},
+		{name: "MySQL 5.7", err: &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'a@b.com' for key 'uk_email'"}, wantDup: true, wantIndex: "uk_email"},
+		{name: "gorm 转换后的错误", err: gorm.ErrDuplicatedKey, wantDup: true},
+		{name: "其他错误", err: &mysql.MySQLError{Number: 1146, Message: "Table 'users' doesn't exist"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapDuplicateKey(tt.err)
+			assert.Equal(t, tt.wantDup, errors.Is(err, ErrDuplicateKey))
+			var dup *DuplicateKeyError
+			if errors.As(err, &dup) {
+				assert.Equal(t, tt.wantIndex, dup.Index)
+			}
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+}
@@ -1,9 +1,10 @@
 package router
 
 import (
-	"net/http"
+	"time"
 
-	"godemo/config"
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
 	godemoMiddleware "godemo/internal/middleware"
 	"godemo/internal/wire"
 
@@ -17,7 +18,14 @@ import (
 
 // InitRouter 初始化路由
 func InitRouter(r *gin.Engine, apis *wire.APIs) *gin.Engine {
-	r.Use(middleware.Trace(), godemoMiddleware.TrimMiddleware(), middleware.IOLog(nil), middleware.Recovery(), middleware.Prometheus(), middleware.Cros(config.BusinessCfg.Cros))
+	// 带有 trim:"-" tag 的请求字段不做 trim
+	trimCfg := godemoMiddleware.DefaultTrimConfig()
+	trimCfg.SkipStructs = []interface{}{dto.UserCreateRequest{}}
+
+	// SecurityHeaders 在最外层，所有响应都带有安全响应头
+	// Compress 在 IOLog 外层，日志中记录的是压缩前的响应
+	// Maintenance、ClientVersion 在 Cors 之后，维护及强制升级的响应带有跨域头；IOLog 在请求处理完成后记录日志，同样带有设备 ID
+	r.Use(middleware.Trace(), godemoMiddleware.SecurityHeaders(), godemoMiddleware.Compress(nil), godemoMiddleware.TrimMiddleware(trimCfg), godemoMiddleware.IOLog(nil), middleware.Recovery(), middleware.Prometheus(), godemoMiddleware.Cors(), godemoMiddleware.Maintenance(), godemoMiddleware.ClientVersion(), apis.RateLimiter.Middleware())
 	r.NoMethod(HandleNotFound)
 	r.NoRoute(HandleNotFound)
 
@@ -31,12 +39,13 @@ func InitRouter(r *gin.Engine, apis *wire.APIs) *gin.Engine {
 }
 
 func HandleNotFound(c *gin.Context) {
-	c.Status(http.StatusNotFound)
+	apperr.Render(c, apperr.ErrNotFound)
 }
 
 func registerSystemRoutes(r *gin.Engine) {
-	// 组件探活
-	r.GET("/health/check", func(c *gin.Context) {
+	// 组件探活，会暴露依赖组件的状态，只允许 [business.security.ip_rules.system] 中的 IP 访问
+	system := r.Group("", godemoMiddleware.IPFilter("system"))
+	system.GET("/health/check", func(c *gin.Context) {
 		data := map[string]interface{}{
 			"mysql":   mysql.HealthCheck(),
 			"redis":   redis.HealthCheck(),
@@ -46,14 +55,43 @@ func registerSystemRoutes(r *gin.Engine) {
 	})
 }
 
+// AreaCacheTag 省市区接口响应缓存的标签，省市区数据热更新后按此标签清除
+const AreaCacheTag = "areas"
+
+// 响应缓存规则，写接口成功后按标签清除
+var (
+	userListCache = godemoMiddleware.CacheRule{TTL: 30 * time.Second, Tags: []string{"users"}}
+	areaCache     = godemoMiddleware.CacheRule{TTL: time.Minute, Tags: []string{AreaCacheTag}}
+)
+
 func registerAPIRoutes(r *gin.Engine, apis *wire.APIs) {
 	v1 := r.Group("/api/v1")
 	{
 		// 用户相关路由
-		user := v1.Group("/users")
+		user := v1.Group("/users", godemoMiddleware.Timeout("users"))
+		{
+			user.POST("", apis.Idempotency.Middleware(), apis.ResponseCache.PurgeOnSuccess("users"), apis.UserHandler.Create) // 创建用户，支持 Idempotency-Key
+			user.GET("", godemoMiddleware.ETag(), apis.ResponseCache.Cache(userListCache), apis.UserHandler.List)             // 获取用户列表，支持 If-None-Match
+		}
+
+		// 地址相关路由
+		address := v1.Group("/address", godemoMiddleware.Timeout("address"))
+		{
+			address.POST("/parse", apis.AddressHandler.Parse) // 解析地址
+		}
+
+		// 合作方服务端调用的路由，使用 API Key 及 HMAC 签名认证，可以通过 [business.security.ip_rules.partner] 限制来源 IP
+		partner := v1.Group("/partner", godemoMiddleware.IPFilter("partner"), godemoMiddleware.Timeout("partner"), apis.APIKeyAuth.Middleware(), apis.RateLimiter.AuthenticatedMiddleware())
+		{
+			partner.GET("/users", godemoMiddleware.RequireScope("users:read"), apis.UserHandler.List)                                                                                // 获取用户列表
+			partner.POST("/users", godemoMiddleware.RequireScope("users:write"), apis.Idempotency.Middleware(), apis.ResponseCache.PurgeOnSuccess("users"), apis.UserHandler.Create) // 创建用户
+		}
+
+		// 省市区相关路由
+		areas := v1.Group("/areas", godemoMiddleware.Timeout("areas"))
 		{
-			user.POST("", apis.UserHandler.Create) // 创建用户
-			user.GET("", apis.UserHandler.List)    // 获取用户列表
+			areas.GET("/search", godemoMiddleware.ETag(), apis.ResponseCache.Cache(areaCache), apis.AreaHandler.Search)   // 拼音搜索省市区，支持 If-None-Match
+			areas.GET("/version", godemoMiddleware.ETag(), apis.ResponseCache.Cache(areaCache), apis.AreaHandler.Version) // 省市区数据版本，支持 If-None-Match
 		}
 	}
 }
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"godemo/internal/area"
+	"godemo/internal/dto"
+)
+
+// AddressService 地址服务
+type AddressService struct{}
+
+// NewAddressService 创建地址服务
+func NewAddressService() *AddressService {
+	return &AddressService{}
+}
+
+// Parse 解析自由文本地址，识别省市区及详细地址
+func (s *AddressService) Parse(ctx context.Context, req *dto.AddressParseRequest) (*dto.AddressParseResponse, error) {
+	result := area.ParseAddress(req.Address)
+
+	candidates := make([]dto.AddressRegion, 0, len(result.Candidates))
+	for _, region := range result.Candidates {
+		candidates = append(candidates, toAddressRegion(region))
+	}
+
+	return &dto.AddressParseResponse{
+		AddressRegion: toAddressRegion(result.Region),
+		Detail:        result.Detail,
+		Confidence:    result.Confidence,
+		Candidates:    candidates,
+	}, nil
+}
+
+// toAddressRegion 转换为响应格式
+func toAddressRegion(region area.Region) dto.AddressRegion {
+	return dto.AddressRegion{
+		ProvinceCode: region.ProvinceCode,
+		ProvinceName: region.ProvinceName,
+		CityCode:     region.CityCode,
+		CityName:     region.CityName,
+		DistrictCode: region.DistrictCode,
+		DistrictName: region.DistrictName,
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"godemo/config"
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
+	"godemo/internal/model"
+	"godemo/internal/repository"
+	"godemo/internal/wire/provider"
+
+	"github.com/jessewkun/gocommon/db/mysql"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/jessewkun/gocommon/safego"
+)
+
+const (
+	// APIKeyMaxSkew 请求时间戳与服务器时间允许的最大偏差
+	APIKeyMaxSkew = 5 * time.Minute
+	// apiKeyNonceMaxLen nonce 的最大长度
+	apiKeyNonceMaxLen = 64
+	// apiKeyTouchInterval 最后调用时间的更新间隔，避免每个请求都写库
+	apiKeyTouchInterval = time.Minute
+)
+
+// SignedRequest 带有 HMAC 签名的请求
+type SignedRequest struct {
+	KeyID     string // X-Api-Key
+	Timestamp string // X-Timestamp，Unix 秒
+	Nonce     string // X-Nonce，每个请求唯一
+	Signature string // X-Signature，十六进制
+	Method    string
+	Path      string
+	RawQuery  string
+	Body      []byte
+}
+
+// errAPIKeyPepperMissing 没有配置 [business.api_key] pepper
+var errAPIKeyPepperMissing = errors.New("api key pepper is not configured")
+
+// deriveAPIKeySecret 由服务端的 pepper、key 标识及随机盐派生 secret，即 HMAC-SHA256(pepper, keyID:salt) 的十六进制
+//
+// pepper 不保存在数据库中，只读取 api_keys 表无法得到 secret，也就无法伪造签名
+func deriveAPIKeySecret(pepper, keyID, salt string) string {
+	mac := hmac.New(sha256.New, []byte(pepper))
+	mac.Write([]byte(keyID + ":" + salt))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignAPIRequest 计算请求签名，调用方与服务端使用相同的算法
+//
+// 签名内容为以下各项以换行连接：请求方法、路径、按参数名排序的 query、时间戳、nonce、请求体 SHA-256 的十六进制，
+// 签名为以 secret 为密钥的 HMAC-SHA256 的十六进制
+func SignAPIRequest(secret, method, path, rawQuery, timestamp, nonce string, body []byte) (string, error) {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", err
+	}
+	bodyHash := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{
+		strings.ToUpper(method),
+		path,
+		query.Encode(),
+		timestamp,
+		nonce,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// APIKeyService API Key 服务
+type APIKeyService struct {
+	repo   repository.APIKeyRepository // API Key 仓储
+	cache  provider.MainCache          // 缓存连接，保存已使用的 nonce
+	pepper func() string               // 派生 secret 的服务端密钥
+	now    func() time.Time
+}
+
+// NewAPIKeyService 创建 API Key 服务，pepper 使用 BusinessCfg 中的 [business.api_key] 配置
+func NewAPIKeyService(repo repository.APIKeyRepository, cache provider.MainCache) *APIKeyService {
+	return &APIKeyService{
+		repo:   repo,
+		cache:  cache,
+		pepper: func() string { return config.BusinessCfg.APIKeyConfig().Pepper },
+		now:    time.Now,
+	}
+}
+
+// Create 创建 API Key，secret 不保存在数据库中，只在创建时返回
+func (s *APIKeyService) Create(ctx context.Context, req *dto.APIKeyCreateRequest) (*dto.APIKeyCreateResponse, error) {
+	pepper := s.pepper()
+	if pepper == "" {
+		return nil, apperr.ErrInternal.Wrap(errAPIKeyPepperMissing)
+	}
+	keyID, err := randomToken(12)
+	if err != nil {
+		return nil, apperr.ErrInternal.Wrap(err)
+	}
+	salt, err := randomToken(32)
+	if err != nil {
+		return nil, apperr.ErrInternal.Wrap(err)
+	}
+
+	key := &model.APIKey{
+		KeyID:  "ak_" + keyID,
+		Name:   req.Name,
+		Salt:   salt,
+		Scopes: strings.Join(req.Scopes, ","),
+	}
+	if req.ExpiresIn > 0 {
+		key.ExpiresAt = mysql.DateTime(s.now().Add(req.ExpiresIn))
+	}
+	if err := s.repo.Create(ctx, key); err != nil {
+		return nil, apperr.ErrInternal.Wrap(err)
+	}
+
+	resp := &dto.APIKeyCreateResponse{
+		KeyID:  key.KeyID,
+		Secret: deriveAPIKeySecret(pepper, key.KeyID, salt),
+		Scopes: key.Scopes,
+	}
+	if !time.Time(key.ExpiresAt).IsZero() {
+		resp.ExpiresAt = key.ExpiresAt.String()
+	}
+	return resp, nil
+}
+
+// List 获取全部 API Key
+func (s *APIKeyService) List(ctx context.Context) ([]*model.APIKey, error) {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, apperr.ErrInternal.Wrap(err)
+	}
+	return keys, nil
+}
+
+// Revoke 停用 API Key
+func (s *APIKeyService) Revoke(ctx context.Context, keyID string) error {
+	ok, err := s.repo.Revoke(ctx, keyID, s.now())
+	if err != nil {
+		return apperr.ErrInternal.Wrap(err)
+	}
+	if !ok {
+		return apperr.ErrNotFound.WithMessage("API Key 不存在或已停用")
+	}
+	return nil
+}
+
+// Verify 校验请求的时间戳、API Key 及签名，通过后记录 nonce 防止重放
+func (s *APIKeyService) Verify(ctx context.Context, req *SignedRequest) (*model.APIKey, error) {
+	if req.KeyID == "" || req.Timestamp == "" || req.Nonce == "" || req.Signature == "" {
+		return nil, apperr.ErrSignatureInvalid.WithMessage("缺少签名请求头")
+	}
+	if len(req.Nonce) > apiKeyNonceMaxLen {
+		return nil, apperr.ErrSignatureInvalid.WithMessage(fmt.Sprintf("nonce 不能超过 %d 个字符", apiKeyNonceMaxLen))
+	}
+	ts, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return nil, apperr.ErrSignatureInvalid.WithMessage("时间戳格式错误")
+	}
+	now := s.now()
+	if skew := now.Sub(time.Unix(ts, 0)); skew > APIKeyMaxSkew || skew < -APIKeyMaxSkew {
+		return nil, apperr.ErrRequestReplayed
+	}
+
+	key, err := s.repo.FindByKeyID(ctx, req.KeyID)
+	if err != nil {
+		return nil, apperr.ErrInternal.Wrap(err)
+	}
+	if key == nil || !key.Active(now) {
+		return nil, apperr.ErrAPIKeyInvalid
+	}
+
+	pepper := s.pepper()
+	if pepper == "" {
+		return nil, apperr.ErrUnavailable.Wrap(errAPIKeyPepperMissing)
+	}
+	expected, err := SignAPIRequest(deriveAPIKeySecret(pepper, key.KeyID, key.Salt), req.Method, req.Path, req.RawQuery, req.Timestamp, req.Nonce, req.Body)
+	if err != nil {
+		return nil, apperr.ErrSignatureInvalid.Wrap(err)
+	}
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(req.Signature))) {
+		return nil, apperr.ErrSignatureInvalid
+	}
+
+	// 签名通过后再记录 nonce，避免伪造的请求占用 nonce。nonce 的保留时间覆盖时间戳的整个允许范围
+	nonceKey := "apikey:nonce:" + key.KeyID + ":" + req.Nonce
+	ok, err := s.cache.SetNX(ctx, nonceKey, 1, 2*APIKeyMaxSkew).Result()
+	if err != nil {
+		// 无法确认是否重放时拒绝请求
+		return nil, apperr.ErrUnavailable.Wrap(err)
+	}
+	if !ok {
+		return nil, apperr.ErrRequestReplayed
+	}
+
+	s.touch(ctx, key, now)
+	return key, nil
+}
+
+// touch 异步更新最后调用时间，距上次更新不足 apiKeyTouchInterval 时不更新
+func (s *APIKeyService) touch(ctx context.Context, key *model.APIKey, now time.Time) {
+	if now.Sub(time.Time(key.LastUsedAt)) < apiKeyTouchInterval {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go safego.SafeGo(ctx, func() {
+		if err := s.repo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			logger.Error(ctx, "API_KEY", fmt.Errorf("update api key %s last used failed: %w", key.KeyID, err))
+		}
+	})
+}
+
+// randomToken 生成 n 字节随机数的 base64url 编码
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
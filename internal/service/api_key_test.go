@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
+	"godemo/internal/model"
+	"godemo/internal/wire/provider"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/jessewkun/gocommon/db/mysql"
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAPIKeyRepository 内存中的 API Key 仓储
+type memoryAPIKeyRepository struct {
+	mu      sync.Mutex
+	keys    map[string]*model.APIKey
+	touched chan int
+}
+
+func newMemoryAPIKeyRepository() *memoryAPIKeyRepository {
+	return &memoryAPIKeyRepository{keys: map[string]*model.APIKey{}, touched: make(chan int, 10)}
+}
+
+func (r *memoryAPIKeyRepository) Create(ctx context.Context, key *model.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key.ID = len(r.keys) + 1
+	r.keys[key.KeyID] = key
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) FindByKeyID(ctx context.Context, keyID string) (*model.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if key, ok := r.keys[keyID]; ok {
+		copied := *key
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryAPIKeyRepository) List(ctx context.Context) ([]*model.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	keys := make([]*model.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *memoryAPIKeyRepository) Revoke(ctx context.Context, keyID string, at time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.keys[keyID]
+	if !ok || !time.Time(key.RevokedAt).IsZero() {
+		return false, nil
+	}
+	key.RevokedAt = mysql.DateTime(at)
+	return true, nil
+}
+
+func (r *memoryAPIKeyRepository) TouchLastUsed(ctx context.Context, id int, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range r.keys {
+		if key.ID == id {
+			key.LastUsedAt = mysql.DateTime(at)
+		}
+	}
+	r.touched <- id
+	return nil
+}
+
+func TestAPIKeyVerify(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	repo := newMemoryAPIKeyRepository()
+	svc := NewAPIKeyService(repo, provider.MainCache{UniversalClient: client})
+	svc.pepper = func() string { return "test-pepper" }
+	now := time.Unix(1700000000, 0)
+	svc.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	created, err := svc.Create(ctx, &dto.APIKeyCreateRequest{Name: "partner", Scopes: []string{"users:read"}, ExpiresIn: time.Hour})
+	require.NoError(t, err)
+	assert.Regexp(t, `^ak_`, created.KeyID)
+	stored, _ := repo.FindByKeyID(ctx, created.KeyID)
+	assert.NotContains(t, created.Secret, stored.Salt)
+	assert.Equal(t, deriveAPIKeySecret("test-pepper", stored.KeyID, stored.Salt), created.Secret)
+	assert.NotEqual(t, deriveAPIKeySecret("other-pepper", stored.KeyID, stored.Salt), created.Secret)
+
+	revoked, err := svc.Create(ctx, &dto.APIKeyCreateRequest{Name: "revoked", Scopes: []string{"*"}})
+	require.NoError(t, err)
+	require.NoError(t, svc.Revoke(ctx, revoked.KeyID))
+	assert.True(t, errors.Is(svc.Revoke(ctx, revoked.KeyID), apperr.ErrNotFound))
+
+	body := []byte(`{"username":"tom"}`)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signed := func(keyID, secret, timestamp, nonce string) *SignedRequest {
+		signature, err := SignAPIRequest(secret, "POST", "/api/v1/partner/users", "b=2&a=1", timestamp, nonce, body)
+		require.NoError(t, err)
+		return &SignedRequest{
+			KeyID:     keyID,
+			Timestamp: timestamp,
+			Nonce:     nonce,
+			Signature: signature,
+			Method:    "POST",
+			Path:      "/api/v1/partner/users",
+			RawQuery:  "a=1&b=2",
+			Body:      body,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     func() *SignedRequest
+		wantErr error
+	}{
+		{name: "签名正确", req: func() *SignedRequest { return signed(created.KeyID, created.Secret, timestamp, "n1") }},
+		{name: "nonce 重复", req: func() *SignedRequest { return signed(created.KeyID, created.Secret, timestamp, "n1") }, wantErr: apperr.ErrRequestReplayed},
+		{name: "时间戳过期", req: func() *SignedRequest {
+			return signed(created.KeyID, created.Secret, strconv.FormatInt(now.Add(-6*time.Minute).Unix(), 10), "n2")
+		}, wantErr: apperr.ErrRequestReplayed},
+		{name: "缺少签名", req: func() *SignedRequest {
+			req := signed(created.KeyID, created.Secret, timestamp, "n3")
+			req.Signature = ""
+			return req
+		}, wantErr: apperr.ErrSignatureInvalid},
+		{name: "请求体被篡改", req: func() *SignedRequest {
+			req := signed(created.KeyID, created.Secret, timestamp, "n4")
+			req.Body = []byte(`{"username":"jerry"}`)
+			return req
+		}, wantErr: apperr.ErrSignatureInvalid},
+		{name: "secret 错误", req: func() *SignedRequest { return signed(created.KeyID, "wrong", timestamp, "n5") }, wantErr: apperr.ErrSignatureInvalid},
+		{name: "key 不存在", req: func() *SignedRequest { return signed("ak_unknown", created.Secret, timestamp, "n6") }, wantErr: apperr.ErrAPIKeyInvalid},
+		{name: "key 已停用", req: func() *SignedRequest { return signed(revoked.KeyID, revoked.Secret, timestamp, "n7") }, wantErr: apperr.ErrAPIKeyInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := svc.Verify(ctx, tt.req())
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				assert.Nil(t, key)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, created.KeyID, key.KeyID)
+		})
+	}
+
+	// 第一次调用成功后异步更新最后调用时间
+	select {
+	case id := <-repo.touched:
+		assert.Equal(t, stored.ID, id)
+	case <-time.After(time.Second):
+		t.Fatal("last used not updated")
+	}
+
+	// 过期后不可用
+	now = now.Add(2 * time.Hour)
+	_, err = svc.Verify(ctx, signed(created.KeyID, created.Secret, strconv.FormatInt(now.Unix(), 10), "n8"))
+	assert.True(t, errors.Is(err, apperr.ErrAPIKeyInvalid))
+}
+
+func TestAPIKeyVerifyRedisDown(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	defer client.Close()
+	svc := NewAPIKeyService(newMemoryAPIKeyRepository(), provider.MainCache{UniversalClient: client})
+	svc.pepper = func() string { return "test-pepper" }
+
+	ctx := context.Background()
+	created, err := svc.Create(ctx, &dto.APIKeyCreateRequest{Name: "partner", Scopes: []string{"*"}})
+	require.NoError(t, err)
+	mr.Close()
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature, err := SignAPIRequest(created.Secret, "GET", "/api/v1/partner/users", "", timestamp, "n1", nil)
+	require.NoError(t, err)
+
+	// 无法确认 nonce 是否已使用时拒绝请求
+	_, err = svc.Verify(ctx, &SignedRequest{
+		KeyID: created.KeyID, Timestamp: timestamp, Nonce: "n1", Signature: signature,
+		Method: "GET", Path: "/api/v1/partner/users",
+	})
+	assert.True(t, errors.Is(err, apperr.ErrUnavailable))
+}
+
+func TestAPIKeyPepperMissing(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	svc := NewAPIKeyService(newMemoryAPIKeyRepository(), provider.MainCache{UniversalClient: client})
+	svc.pepper = func() string { return "test-pepper" }
+
+	ctx := context.Background()
+	created, err := svc.Create(ctx, &dto.APIKeyCreateRequest{Name: "partner", Scopes: []string{"*"}})
+	require.NoError(t, err)
+
+	// 没有配置 pepper 时不能创建，也不能校验
+	svc.pepper = func() string { return "" }
+	_, err = svc.Create(ctx, &dto.APIKeyCreateRequest{Name: "partner", Scopes: []string{"*"}})
+	assert.True(t, errors.Is(err, apperr.ErrInternal))
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature, err := SignAPIRequest(created.Secret, "GET", "/api/v1/partner/users", "", timestamp, "n1", nil)
+	require.NoError(t, err)
+	_, err = svc.Verify(ctx, &SignedRequest{
+		KeyID: created.KeyID, Timestamp: timestamp, Nonce: "n1", Signature: signature,
+		Method: "GET", Path: "/api/v1/partner/users",
+	})
+	assert.True(t, errors.Is(err, apperr.ErrUnavailable))
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"godemo/internal/area"
+	"godemo/internal/dto"
+)
+
+// 未指定数量时默认返回的搜索结果数
+const defaultAreaSearchLimit = 20
+
+// AreaService 省市区服务
+type AreaService struct{}
+
+// NewAreaService 创建省市区服务
+func NewAreaService() *AreaService {
+	return &AreaService{}
+}
+
+// Search 按汉字、全拼、首字母搜索省市区
+func (s *AreaService) Search(ctx context.Context, req *dto.AreaSearchRequest) (*dto.AreaSearchResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultAreaSearchLimit
+	}
+
+	results := area.Current().Search(req.Keyword, limit)
+	list := make([]dto.AreaSearchItem, 0, len(results))
+	for _, r := range results {
+		list = append(list, dto.AreaSearchItem{
+			AddressRegion: toAddressRegion(r.Region),
+			Code:          r.Code,
+			Name:          r.Name,
+			Level:         int(r.Level),
+			Pinyin:        r.Pinyin,
+		})
+	}
+
+	return &dto.AreaSearchResponse{List: list}, nil
+}
+
+// Version 返回当前生效的省市区数据版本及已撤销编码的继任关系
+func (s *AreaService) Version(ctx context.Context) (*dto.AreaVersionResponse, error) {
+	dataset := area.Current()
+	successors := make(map[string]string, len(dataset.Successors))
+	for code := range dataset.Successors {
+		successors[code], _ = dataset.Successor(code)
+	}
+
+	return &dto.AreaVersionResponse{
+		Version:    dataset.Version,
+		Successors: successors,
+	}, nil
+}
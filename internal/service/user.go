@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"godemo/internal/apperr"
 	"godemo/internal/dto"
 	"godemo/internal/model"
 	"godemo/internal/repository"
@@ -32,9 +34,24 @@ func (s *UserService) Create(ctx context.Context, req *dto.UserCreateRequest) (*
 		Email:    req.Email,
 	}
 
-	// 使用仓储创建用户
+	existing, err := s.repo.FindByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, apperr.ErrInternal.Wrap(err)
+	}
+	if existing != nil {
+		return nil, apperr.ErrUsernameTaken
+	}
+
+	// 使用仓储创建用户，并发创建同名用户时由用户名的唯一索引兜底，其他唯一索引冲突返回通用的资源冲突
 	if err := s.repo.Create(ctx, user); err != nil {
-		return nil, err
+		var dup *repository.DuplicateKeyError
+		if errors.As(err, &dup) && dup.Index == repository.UserUsernameIndex {
+			return nil, apperr.ErrUsernameTaken.Wrap(err)
+		}
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, apperr.ErrConflict.Wrap(err)
+		}
+		return nil, apperr.ErrInternal.Wrap(err)
 	}
 
 	// 使用缓存连接缓存用户信息
@@ -57,7 +74,7 @@ func (s *UserService) List(ctx context.Context, req *dto.UserListRequest) (*dto.
 	// 使用仓储获取用户列表
 	users, total, err := s.repo.List(ctx, (req.Page-1)*req.PageSize, req.PageSize, req.Keyword)
 	if err != nil {
-		return nil, err
+		return nil, apperr.ErrInternal.Wrap(err)
 	}
 
 	// 转换为响应格式
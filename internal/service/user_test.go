@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"godemo/internal/apperr"
+	"godemo/internal/dto"
+	"godemo/internal/model"
+	"godemo/internal/repository"
+	"godemo/internal/wire/provider"
+
+	"github.com/jessewkun/gocommon/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubUserRepository Create 返回固定错误的用户仓储
+type stubUserRepository struct {
+	repository.UserRepository
+	createErr error
+}
+
+func (r *stubUserRepository) FindByUsername(ctx context.Context, username string) (*model.User, error) {
+	return nil, nil
+}
+
+func (r *stubUserRepository) Create(ctx context.Context, user *model.User) error {
+	return r.createErr
+}
+
+func TestUserCreateDuplicateKey(t *testing.T) {
+	logger.Cfg.Closed = true
+
+	tests := []struct {
+		name      string
+		createErr error
+		wantErr   error
+	}{
+		{name: "用户名冲突", createErr: &repository.DuplicateKeyError{Index: repository.UserUsernameIndex}, wantErr: apperr.ErrUsernameTaken},
+		{name: "其他唯一索引冲突", createErr: &repository.DuplicateKeyError{Index: "uk_email"}, wantErr: apperr.ErrConflict},
+		{name: "无法解析索引", createErr: &repository.DuplicateKeyError{}, wantErr: apperr.ErrConflict},
+		{name: "其他错误", createErr: errors.New("connection refused"), wantErr: apperr.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewUserService(&stubUserRepository{createErr: tt.createErr}, provider.MainCache{})
+			_, err := svc.Create(context.Background(), &dto.UserCreateRequest{Username: "tom", Password: "secret", Email: "tom@example.com"})
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	"godemo/internal/repository"
+	"godemo/internal/service"
+	"godemo/internal/wire/provider"
+
+	"github.com/google/wire"
+)
+
+// InitializeAPIKeyService initializes the API key service for the management command.
+func InitializeAPIKeyService() (*service.APIKeyService, error) {
+	panic(wire.Build(
+		provider.ProvideMainDB,
+		wire.Value(provider.MainDBNameValue),
+		provider.ProvideMainCache,
+		wire.Value(provider.MainCacheNameValue),
+
+		repository.NewAPIKeyRepository,
+		service.NewAPIKeyService,
+	))
+}
@@ -0,0 +1,12 @@
+package wire
+
+import (
+	"godemo/internal/middleware"
+
+	"github.com/google/wire"
+)
+
+// MiddlewareSet aggregates all middleware provider sets.
+var MiddlewareSet = wire.NewSet(
+	middleware.ProviderSet,
+)
@@ -5,13 +5,21 @@ package wire
 
 import (
 	"godemo/internal/handler"
+	"godemo/internal/middleware"
 	"godemo/internal/wire/provider"
 
 	"github.com/google/wire"
 )
 
 type APIs struct {
-	UserHandler *handler.UserHandler
+	UserHandler    *handler.UserHandler
+	AddressHandler *handler.AddressHandler
+	AreaHandler    *handler.AreaHandler
+
+	RateLimiter   *middleware.RateLimiter
+	Idempotency   *middleware.Idempotency
+	ResponseCache *middleware.ResponseCache
+	APIKeyAuth    *middleware.APIKeyAuth
 }
 
 func InitializeAPIs() (*APIs, error) {
@@ -26,6 +34,7 @@ func InitializeAPIs() (*APIs, error) {
 		RepositorySet,
 		ServiceSet,
 		HandlerSet,
+		MiddlewareSet,
 
 		// The final struct to build
 		wire.Struct(new(APIs), "*"),